@@ -16,6 +16,8 @@ type AgentConfig struct {
 	OpensnitchRuleDir         string `mapstructure:"opensnitch-rule-dir"`
 	AuthzSock                 string `mapstructure:"authz-sock"`
 	BackupJob                 string `mapstructure:"backup-job"`
+	BackupConcurrency         int    `mapstructure:"backup-concurrency"` // 同时执行的备份任务数
+	SeccompProfileDir         string `mapstructure:"seccomp-profile-dir"`
 }
 
 func (m *AgentConfig) Addr() string {
@@ -23,14 +25,19 @@ func (m *AgentConfig) Addr() string {
 }
 
 type ControllerConfig struct {
-	Host        string `mapstructure:"host"`
-	Port        uint   `mapstructure:"port"`
-	VirtualIf   string `mapstructure:"virtual-if"`
-	VirtualIP   string `mapstructure:"virtual-ip"`
-	ImageDir    string `mapstructure:"image-dir"`
-	ImageSigner string `mapstructure:"image-signer"`
-	CheckAuth   bool   `mapstructure:"check-auth"`
-	CheckPerm   bool   `mapstructure:"check-perm"`
+	Host             string `mapstructure:"host"`
+	Port             uint   `mapstructure:"port"`
+	VirtualIf        string `mapstructure:"virtual-if"`
+	VirtualIP        string `mapstructure:"virtual-ip"`
+	ImageDir         string `mapstructure:"image-dir"`
+	ImageSigner      string `mapstructure:"image-signer"`
+	CheckAuth        bool   `mapstructure:"check-auth"`
+	CheckPerm        bool   `mapstructure:"check-perm"`
+	ScheduleStrategy string `mapstructure:"schedule-strategy"` // 自动调度策略 spread|binpack
+	FailoverGrace    int64  `mapstructure:"failover-grace"`    // 节点离线超过此时长(秒)后故障转移高可用容器
+	FailoverWorkers  int    `mapstructure:"failover-workers"`  // 同时执行的故障转移任务数
+	BackupSignKey    string `mapstructure:"backup-sign-key"`   // 备份文件清单签名私钥, 不存在时自动生成
+	UploadTimeout    int64  `mapstructure:"upload-timeout"`    // 镜像上传会话超过此时长(秒)未更新时清理
 	// cert
 }
 
@@ -80,6 +87,7 @@ type VirtualNicConfig struct {
 type NetworkConfig struct {
 	IPtablesJsonFile string `mapstructure:"iptables_json_file"`
 	IPtablesPath     string `mapstructure:"iptables_path"`
+	BandwidthPath    string `mapstructure:"bandwidth_path"`
 }
 
 type RegistryConfig struct {
@@ -113,8 +121,10 @@ func setDefault() {
 	viper.SetDefault("agent.container-extra-data-basedir", "/var/lib/ks-scmc/containers")
 	viper.SetDefault("agent.container-backup-basedir", "/var/lib/ks-scmc/backups")
 	viper.SetDefault("agent.opensnitch-rule-dir", "/etc/opensnitchd/rules")
+	viper.SetDefault("agent.seccomp-profile-dir", "/var/lib/ks-scmc/seccomp")
 	viper.SetDefault("agent.authz-sock", "/var/lib/ks-scmc/authz.sock")
 	viper.SetDefault("agent.backup-job", "/var/lib/ks-scmc/backup_job.json")
+	viper.SetDefault("agent.backup-concurrency", 2)
 
 	viper.SetDefault("controller.host", "0.0.0.0")
 	viper.SetDefault("controller.port", 10050)
@@ -122,6 +132,11 @@ func setDefault() {
 	viper.SetDefault("controller.image-signer", "/var/lib/ks-scmc/images/public-key.txt")
 	viper.SetDefault("controller.check-auth", true)
 	viper.SetDefault("controller.check-perm", true)
+	viper.SetDefault("controller.schedule-strategy", "spread")
+	viper.SetDefault("controller.failover-grace", 300)
+	viper.SetDefault("controller.failover-workers", 2)
+	viper.SetDefault("controller.backup-sign-key", "/var/lib/ks-scmc/backup-sign.key")
+	viper.SetDefault("controller.upload-timeout", 3600)
 
 	viper.SetDefault("mysql.addr", "127.0.0.1:3306")
 	viper.SetDefault("mysql.user", "root")
@@ -135,6 +150,7 @@ func setDefault() {
 
 	viper.SetDefault("network.iptables_json_file", "/var/lib/ks-scmc/networks/iptables/enable.json")
 	viper.SetDefault("network.iptables_path", "/var/lib/ks-scmc/networks/iptables")
+	viper.SetDefault("network.bandwidth_path", "/var/lib/ks-scmc/networks/bandwidth")
 
 	viper.SetDefault("registry.secure", false)
 	viper.SetDefault("registry.addr", "127.0.0.1:5000")
@@ -0,0 +1,547 @@
+package model
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/sirupsen/logrus"
+
+	"scmc/common"
+)
+
+// 备份文件中的镜像及挂载数据归档路径
+const (
+	BackupImageFile = "image.tar"
+	BackupMountsDir = "mounts/"
+)
+
+// BackupArchive 容器挂载点数据归档
+type BackupArchive struct {
+	Destination string `json:"destination"`
+	Type        string `json:"type"`
+	File        string `json:"file"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+}
+
+func backupArchiveDir(backupName string) string {
+	return filepath.Join(common.Config.Agent.ContainerBackupBasedir, backupName)
+}
+
+// 统计已读取字节数, 任务取消时中止读取
+type progressReader struct {
+	ctx      context.Context
+	r        io.Reader
+	progress func(int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	if err := p.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := p.r.Read(b)
+	if n > 0 && p.progress != nil {
+		p.progress(int64(n))
+	}
+	return n, err
+}
+
+// 需要归档的挂载点 单个文件的绑定挂载通常为配置文件, 不归档
+func archivableMount(m types.MountPoint) bool {
+	if m.Type != mount.TypeBind && m.Type != mount.TypeVolume {
+		return false
+	}
+
+	fi, err := os.Stat(m.Source)
+	return err == nil && fi.IsDir()
+}
+
+// 挂载目录中普通文件的总大小
+func dirSize(dir string) int64 {
+	var size int64
+	filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// ArchiveContainerMounts 将容器挂载卷及绑定目录中的数据打包至备份目录, 打包期间暂停容器
+func ArchiveContainerMounts(ctx context.Context, containerID, backupName string, progress func(int64)) ([]*BackupArchive, error) {
+	cli, err := DockerClient()
+	if err != nil {
+		log.Warnf("create docker cli err=%v", err)
+		return nil, err
+	}
+
+	info, err := cli.ContainerInspect(context.Background(), containerID)
+	if err != nil {
+		log.Warnf("inspect container=%v err=%v", containerID, err)
+		return nil, err
+	}
+
+	if info.State != nil && info.State.Running && !info.State.Paused {
+		if err := cli.ContainerPause(context.Background(), containerID); err != nil {
+			log.Warnf("pause container=%v err=%v", containerID, err)
+			return nil, err
+		}
+		defer func() {
+			if err := cli.ContainerUnpause(context.Background(), containerID); err != nil {
+				log.Warnf("unpause container=%v err=%v", containerID, err)
+			}
+		}()
+	}
+
+	dir := backupArchiveDir(backupName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		log.Warnf("create backup dir=%v err=%v", dir, err)
+		return nil, err
+	}
+
+	var archives []*BackupArchive
+	for i, m := range info.Mounts {
+		if !archivableMount(m) {
+			log.Infof("skip archive mount=%v source=%v", m.Destination, m.Source)
+			continue
+		}
+
+		a := BackupArchive{
+			Destination: m.Destination,
+			Type:        string(m.Type),
+			File:        filepath.Join(dir, fmt.Sprintf("mount-%d.tar.gz", i)),
+		}
+		if a.Size, a.Sha256, err = archiveDir(ctx, m.Source, a.File, progress); err != nil {
+			log.Warnf("archive mount=%v source=%v err=%v", m.Destination, m.Source, err)
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		archives = append(archives, &a)
+	}
+
+	return archives, nil
+}
+
+func archiveDir(ctx context.Context, src, file string, progress func(int64)) (int64, string, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	gw := gzip.NewWriter(io.MultiWriter(f, h))
+	tw := tar.NewWriter(gw)
+
+	err = filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		var link string
+		if fi.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		hdr, err := tar.FileInfoHeader(fi, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		r, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		_, err = io.Copy(tw, &progressReader{ctx: ctx, r: r, progress: progress})
+		return err
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	if err := tw.Close(); err != nil {
+		return 0, "", err
+	} else if err := gw.Close(); err != nil {
+		return 0, "", err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, "", err
+	}
+	return fi.Size(), hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyBackupArchives 校验归档文件完整性
+func VerifyBackupArchives(archives []*BackupArchive) error {
+	for _, a := range archives {
+		f, err := os.Open(a.File)
+		if err != nil {
+			return err
+		}
+
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		if sum := hex.EncodeToString(h.Sum(nil)); sum != a.Sha256 {
+			return fmt.Errorf("archive=%v checksum mismatch", a.File)
+		}
+	}
+	return nil
+}
+
+// MountRestore 解压至临时目录的挂载数据, 提交时替换挂载点目录
+type MountRestore struct {
+	dirs []mountRestoreDir
+}
+
+type mountRestoreDir struct {
+	dest string // 容器内路径
+	src  string // 主机上的挂载点目录
+	tmp  string
+}
+
+// PrepareContainerMounts 将归档解压至挂载点同级的临时目录, 不修改挂载点原有数据
+func PrepareContainerMounts(containerID string, archives []*BackupArchive) (*MountRestore, error) {
+	r := &MountRestore{}
+	if len(archives) == 0 {
+		return r, nil
+	}
+
+	if err := VerifyBackupArchives(archives); err != nil {
+		return nil, err
+	}
+
+	cli, err := DockerClient()
+	if err != nil {
+		log.Warnf("create docker cli err=%v", err)
+		return nil, err
+	}
+
+	info, err := cli.ContainerInspect(context.Background(), containerID)
+	if err != nil {
+		log.Warnf("inspect container=%v err=%v", containerID, err)
+		return nil, err
+	}
+
+	sources := make(map[string]string)
+	for _, m := range info.Mounts {
+		sources[m.Destination] = m.Source
+	}
+
+	for _, a := range archives {
+		src, ok := sources[a.Destination]
+		if !ok {
+			r.Abort()
+			return nil, fmt.Errorf("container=%v has no mount %v", containerID, a.Destination)
+		}
+
+		tmp, err := newRestoreDir(src)
+		if err != nil {
+			r.Abort()
+			return nil, err
+		}
+		r.dirs = append(r.dirs, mountRestoreDir{dest: a.Destination, src: src, tmp: tmp})
+
+		if err := extractArchive(a.File, tmp); err != nil {
+			r.Abort()
+			return nil, fmt.Errorf("extract archive=%v to %v: %v", a.File, tmp, err)
+		}
+	}
+	return r, nil
+}
+
+// 临时目录与挂载点位于同一文件系统, 属性与挂载点一致
+func newRestoreDir(src string) (string, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := ioutil.TempDir(filepath.Dir(src), "."+filepath.Base(src)+".restore-")
+	if err != nil {
+		return "", err
+	}
+	os.Chmod(tmp, fi.Mode().Perm())
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		if err := os.Lchown(tmp, int(st.Uid), int(st.Gid)); err != nil {
+			log.Infof("chown %v err=%v", tmp, err)
+		}
+	}
+	return tmp, nil
+}
+
+// Destinations 返回待恢复的挂载点
+func (r *MountRestore) Destinations() []string {
+	var dests []string
+	for _, d := range r.dirs {
+		dests = append(dests, d.dest)
+	}
+	return dests
+}
+
+// Commit 用临时目录替换挂载点目录, 失败时回滚已替换的目录
+func (r *MountRestore) Commit() error {
+	for i, d := range r.dirs {
+		if err := swapDir(d.src, d.tmp); err != nil {
+			for _, done := range r.dirs[:i] {
+				if e := swapDir(done.src, done.tmp); e != nil {
+					log.Warnf("rollback restored dir=%v err=%v", done.src, e)
+				}
+			}
+			return fmt.Errorf("replace %v: %v", d.src, err)
+		}
+	}
+
+	// 替换后临时目录中为原有数据
+	r.Abort()
+	return nil
+}
+
+// Abort 删除临时目录
+func (r *MountRestore) Abort() {
+	for _, d := range r.dirs {
+		if err := os.RemoveAll(d.tmp); err != nil {
+			log.Infof("remove restore dir=%v err=%v", d.tmp, err)
+		}
+	}
+	r.dirs = nil
+}
+
+// 交换两个目录
+func swapDir(a, b string) error {
+	swap := b + ".swap"
+	if err := os.Rename(a, swap); err != nil {
+		return err
+	}
+	if err := os.Rename(b, a); err != nil {
+		os.Rename(swap, a)
+		return err
+	}
+	return os.Rename(swap, b)
+}
+
+func extractArchive(file, dst string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		path, err := archiveEntryPath(dst, hdr.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := os.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, mode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			w, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|syscall.O_NOFOLLOW, mode)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(w, tr)
+			w.Close()
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, path); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+			log.Infof("chown %v err=%v", path, err)
+		}
+		if hdr.Typeflag != tar.TypeSymlink {
+			os.Chmod(path, os.FileMode(hdr.Mode).Perm())
+			os.Chtimes(path, hdr.ModTime, hdr.ModTime)
+		}
+	}
+}
+
+// 归档条目须位于dst内, 且路径上已存在的各级均不能是符号链接, 避免通过归档中的链接写到dst之外
+func archiveEntryPath(dst, name string) (string, error) {
+	dst = filepath.Clean(dst)
+	path := filepath.Join(dst, name)
+	if !strings.HasPrefix(path, dst+string(os.PathSeparator)) {
+		return "", fmt.Errorf("invalid archive entry %q", name)
+	}
+
+	cur := dst
+	for _, p := range strings.Split(path[len(dst)+1:], string(os.PathSeparator)) {
+		cur = filepath.Join(cur, p)
+		fi, err := os.Lstat(cur)
+		if os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return "", err
+		} else if fi.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("archive entry %q through symlink", name)
+		}
+	}
+	return path, nil
+}
+
+// RemoveBackupArchives 删除备份对应的挂载数据归档
+func RemoveBackupArchives(backupName string) error {
+	if backupName == "" || strings.ContainsAny(backupName, "/.") {
+		return fmt.Errorf("invalid backup name %q", backupName)
+	}
+	return os.RemoveAll(backupArchiveDir(backupName))
+}
+
+// IsBackupArchiveFile 检查文件是否位于备份目录中
+func IsBackupArchiveFile(file string) bool {
+	dir := filepath.Clean(common.Config.Agent.ContainerBackupBasedir) + string(os.PathSeparator)
+	return strings.HasPrefix(filepath.Clean(file), dir)
+}
+
+// WriteBackupArchive 保存上传的挂载数据归档至备份目录
+func WriteBackupArchive(backupName, fileName string, r io.Reader) (*BackupArchive, error) {
+	if backupName == "" || strings.ContainsAny(backupName, "/.") {
+		return nil, fmt.Errorf("invalid backup name %q", backupName)
+	}
+
+	dir := backupArchiveDir(backupName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		log.Warnf("create backup dir=%v err=%v", dir, err)
+		return nil, err
+	}
+
+	a := BackupArchive{File: filepath.Join(dir, filepath.Base(fileName))}
+	f, err := os.OpenFile(a.File, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if a.Size, err = io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return nil, err
+	}
+	a.Sha256 = hex.EncodeToString(h.Sum(nil))
+	return &a, nil
+}
+
+// SaveImageFile 保存镜像为docker save格式的临时文件, 由调用者删除
+func SaveImageFile(ctx context.Context, imageRef string) (string, error) {
+	cli, err := DockerClient()
+	if err != nil {
+		return "", err
+	}
+
+	reader, err := cli.ImageSave(ctx, []string{imageRef})
+	if err != nil {
+		log.Warnf("save image=%s err=%v", imageRef, err)
+		return "", err
+	}
+	defer reader.Close()
+
+	f, err := ioutil.TempFile(os.TempDir(), "scmc-backup-*.tar")
+	if err != nil {
+		log.Warnf("create temp file err=%v", err)
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		log.Warnf("write image=%s to file=%s err=%v", imageRef, f.Name(), err)
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// LoadImage 加载docker save格式的镜像数据, 返回镜像ID及大小
+func LoadImage(ctx context.Context, r io.Reader, imageRef string) (string, int64, error) {
+	cli, err := DockerClient()
+	if err != nil {
+		return "", 0, err
+	}
+
+	resp, err := cli.ImageLoad(ctx, r, true)
+	if err != nil {
+		log.Warnf("load image=%s err=%v", imageRef, err)
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	var msg struct {
+		Error string `json:"error"`
+	}
+	dec := json.NewDecoder(resp.Body)
+	for dec.More() {
+		if err := dec.Decode(&msg); err != nil {
+			return "", 0, err
+		} else if msg.Error != "" {
+			return "", 0, errors.New(msg.Error)
+		}
+	}
+
+	info, _, err := cli.ImageInspectWithRaw(ctx, imageRef)
+	if err != nil {
+		log.Warnf("inspect image=%v err=%v", imageRef, err)
+		return "", 0, err
+	}
+	return strings.TrimPrefix(info.ID, "sha256:"), info.Size, nil
+}
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testArchiveEntry struct {
+	name     string
+	typeflag byte
+	body     string
+	linkname string
+}
+
+func writeTestArchive(t *testing.T, file string, entries []testArchiveEntry) {
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatalf("create %v: %v", file, err)
+	}
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		hdr := tar.Header{Name: e.name, Typeflag: e.typeflag, Linkname: e.linkname, Mode: 0644, Size: int64(len(e.body))}
+		if e.typeflag == tar.TypeDir {
+			hdr.Mode = 0755
+		}
+		if err := tw.WriteHeader(&hdr); err != nil {
+			t.Fatalf("write header %v: %v", e.name, err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatalf("write %v: %v", e.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	} else if err := gw.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "backup-archive")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestExtractArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []testArchiveEntry
+		ok      bool
+		files   map[string]string // 解压后dst内应存在的文件
+	}{
+		{
+			name: "regular",
+			entries: []testArchiveEntry{
+				{name: "dir", typeflag: tar.TypeDir},
+				{name: "dir/a.txt", typeflag: tar.TypeReg, body: "a"},
+				{name: "b.txt", typeflag: tar.TypeReg, body: "b"},
+			},
+			ok:    true,
+			files: map[string]string{"dir/a.txt": "a", "b.txt": "b"},
+		},
+		{
+			name: "absolute path stays in dst",
+			entries: []testArchiveEntry{
+				{name: "/etc", typeflag: tar.TypeDir},
+				{name: "/etc/evil", typeflag: tar.TypeReg, body: "x"},
+			},
+			ok:    true,
+			files: map[string]string{"etc/evil": "x"},
+		},
+		{
+			name:    "parent dir",
+			entries: []testArchiveEntry{{name: "../evil", typeflag: tar.TypeReg, body: "x"}},
+		},
+		{
+			name:    "nested parent dir",
+			entries: []testArchiveEntry{{name: "dir/../../evil", typeflag: tar.TypeReg, body: "x"}},
+		},
+		{
+			name:    "dst itself",
+			entries: []testArchiveEntry{{name: "dir/..", typeflag: tar.TypeDir}},
+		},
+		{
+			name: "write through symlink dir",
+			entries: []testArchiveEntry{
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "../outside"},
+				{name: "link/evil", typeflag: tar.TypeReg, body: "x"},
+			},
+		},
+		{
+			name: "overwrite symlink",
+			entries: []testArchiveEntry{
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "../outside/evil"},
+				{name: "link", typeflag: tar.TypeReg, body: "x"},
+			},
+		},
+		{
+			name: "symlink kept as link",
+			entries: []testArchiveEntry{
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "/etc/passwd"},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tt := range tests {
+		root := tempDir(t)
+		dst, outside := filepath.Join(root, "dst"), filepath.Join(root, "outside")
+		for _, dir := range []string{dst, outside} {
+			if err := os.Mkdir(dir, 0755); err != nil {
+				t.Fatalf("mkdir %v: %v", dir, err)
+			}
+		}
+		file := filepath.Join(root, "archive.tar.gz")
+		writeTestArchive(t, file, tt.entries)
+
+		err := extractArchive(file, dst)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: extractArchive err = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+
+		for _, p := range []string{filepath.Join(root, "evil"), filepath.Join(outside, "evil")} {
+			if _, err := os.Lstat(p); err == nil {
+				t.Errorf("%s: extractArchive wrote %v outside dst", tt.name, p)
+			}
+		}
+		for name, want := range tt.files {
+			data, err := ioutil.ReadFile(filepath.Join(dst, name))
+			if err != nil || string(data) != want {
+				t.Errorf("%s: file %v = %q, %v, want %q", tt.name, name, data, err, want)
+			}
+		}
+	}
+}
+
+func TestArchiveDirRoundTrip(t *testing.T) {
+	root := tempDir(t)
+	src, dst := filepath.Join(root, "src"), filepath.Join(root, "dst")
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	} else if err := os.Mkdir(dst, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	ioutil.WriteFile(filepath.Join(src, "sub", "a.txt"), []byte("hello"), 0600)
+	os.Symlink("/etc/hostname", filepath.Join(src, "link"))
+
+	file := filepath.Join(root, "archive.tar.gz")
+	size, sum, err := archiveDir(context.Background(), src, file, func(int64) {})
+	if err != nil {
+		t.Fatalf("archiveDir: %v", err)
+	}
+
+	if err := VerifyBackupArchives([]*BackupArchive{{File: file, Size: size, Sha256: sum}}); err != nil {
+		t.Errorf("VerifyBackupArchives: %v", err)
+	}
+	if err := VerifyBackupArchives([]*BackupArchive{{File: file, Size: size, Sha256: "bad"}}); err == nil {
+		t.Errorf("VerifyBackupArchives bad checksum: want error")
+	}
+
+	if err := extractArchive(file, dst); err != nil {
+		t.Fatalf("extractArchive: %v", err)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(dst, "sub", "a.txt")); err != nil || string(data) != "hello" {
+		t.Errorf("extracted sub/a.txt = %q, %v", data, err)
+	}
+	if fi, err := os.Stat(filepath.Join(dst, "sub", "a.txt")); err != nil {
+		t.Errorf("stat sub/a.txt: %v", err)
+	} else if fi.Mode().Perm() != 0600 {
+		t.Errorf("extracted sub/a.txt mode = %v, want 0600", fi.Mode())
+	}
+	if link, err := os.Readlink(filepath.Join(dst, "link")); err != nil || link != "/etc/hostname" {
+		t.Errorf("extracted link = %q, %v", link, err)
+	}
+}
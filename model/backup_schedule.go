@@ -0,0 +1,113 @@
+package model
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+type BackupSchedule struct {
+	ID           int64  `gorm:"primaryKey"`
+	UUID         string // 容器UUID 容器迁移后仍有效
+	Cron         string
+	KeepCount    int64 // 保留最近的备份数 0表示不限制
+	KeepDays     int64 // 备份保留天数 0表示不限制
+	DescTemplate string
+	WithMounts   bool // 同时备份挂载数据
+	Disabled     bool
+	LastRunAt    int64
+	LastError    string
+	NextRunAt    int64
+	Creator      int64
+	CreatedAt    int64 `gorm:"autoCreateTime"`
+	UpdatedAt    int64 `gorm:"autoUpdateTime"`
+}
+
+func CreateBackupSchedule(data *BackupSchedule) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(data).Error; err != nil {
+		log.Errorf("db create backup schedule %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func UpdateBackupSchedule(data *BackupSchedule) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Save(data).Error; err != nil {
+		log.Errorf("db update backup schedule %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func RemoveBackupSchedule(ids []int64) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Delete(&BackupSchedule{}, ids).Error; err != nil {
+		log.Errorf("db remove backup schedule %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func RemoveBackupScheduleByUUID(uuid []string) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Where("uuid IN ?", uuid).Delete(&BackupSchedule{}).Error; err != nil {
+		log.Errorf("db remove backup schedule, uuid=%v: %v", uuid, err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func QueryBackupScheduleByID(id int64) (*BackupSchedule, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data BackupSchedule
+	if err := db.First(&data, "id = ?", id).Error; err != nil {
+		log.Infof("QueryBackupScheduleByID id=%v err=%v", id, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
+
+// ListBackupSchedule 查询定时备份 uuid为空时返回全部
+func ListBackupSchedule(uuid string) ([]*BackupSchedule, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	if uuid != "" {
+		db = db.Where("uuid = ?", uuid)
+	}
+
+	var data []*BackupSchedule
+	if err := db.Order("id").Find(&data).Error; err != nil {
+		log.Warnf("ListBackupSchedule err=%v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
@@ -13,15 +13,30 @@ import (
 	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
 	log "github.com/sirupsen/logrus"
 )
 
-var backup = &backupJobManager{}
+var (
+	ErrBackupJobNotFound = errors.New("backup job not found")
+	ErrBackupJobDone     = errors.New("backup job already done")
+)
+
+var backup = &backupJobManager{
+	cancels:  make(map[int64]context.CancelFunc),
+	watchers: make(map[chan *ContainerBackupJob]bool),
+	wake:     make(chan struct{}, 1),
+}
 
+// 备份任务队列, 任务保存在文件中, 节点重启后继续执行未完成的任务
 type backupJobManager struct {
 	sync.Mutex
 
-	m map[int64]*ContainerBackupJob
+	m         map[int64]*ContainerBackupJob
+	cancels   map[int64]context.CancelFunc // 执行中的任务
+	watchers  map[chan *ContainerBackupJob]bool
+	wake      chan struct{}
+	lastWrite time.Time
 }
 
 func (b *backupJobManager) readFile() error {
@@ -39,6 +54,9 @@ func (b *backupJobManager) readFile() error {
 		log.Warnf("unmarshal backup job from json err=%v", err)
 		return err
 	}
+	if b.m == nil {
+		b.m = make(map[int64]*ContainerBackupJob)
+	}
 
 	return nil
 }
@@ -55,6 +73,7 @@ func (b *backupJobManager) writeFile() error {
 		return err
 	}
 
+	b.lastWrite = time.Now()
 	return nil
 }
 
@@ -62,8 +81,10 @@ func (b *backupJobManager) withLock(f func() error) error {
 	b.Lock()
 	defer b.Unlock()
 
-	if err := b.readFile(); err != nil {
-		return err
+	if b.m == nil {
+		if err := b.readFile(); err != nil {
+			return err
+		}
 	}
 
 	if err := f(); err != nil {
@@ -73,11 +94,20 @@ func (b *backupJobManager) withLock(f func() error) error {
 	return b.writeFile()
 }
 
-func (b *backupJobManager) add(id int64, containerID, name string) (*ContainerBackupJob, error) {
+// 唤醒空闲的工作协程
+func (b *backupJobManager) notify() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (b *backupJobManager) add(id int64, containerID, name string, withMounts bool) (*ContainerBackupJob, error) {
 	j := ContainerBackupJob{
 		ID:          id,
 		ContainerID: containerID,
 		BackupName:  name,
+		WithMounts:  withMounts,
 		Status:      0,
 		UpdatedAt:   time.Now().Unix(),
 	}
@@ -93,38 +123,175 @@ func (b *backupJobManager) add(id int64, containerID, name string) (*ContainerBa
 	if err != nil {
 		return nil, err
 	}
+
+	b.notify()
 	return &j, nil
 }
 
-func (b *backupJobManager) update(j *ContainerBackupJob) error {
-	if j == nil {
+// update 修改任务状态并保存
+func (b *backupJobManager) update(j *ContainerBackupJob, f func()) error {
+	return b.withLock(func() error {
+		f()
+		j.UpdatedAt = time.Now().Unix()
 		return nil
-	}
+	})
+}
+
+// 更新任务进度, 至多每秒保存一次
+func (b *backupJobManager) addProgress(j *ContainerBackupJob, n int64) {
+	b.Lock()
+	defer b.Unlock()
 
+	j.Progress += n
 	j.UpdatedAt = time.Now().Unix()
-	return b.withLock(func() error {
-		b.m[j.ID] = j
+	if time.Since(b.lastWrite) >= time.Second {
+		b.writeFile()
+	}
+}
+
+// 任务结束后通知订阅者
+func (b *backupJobManager) finish(j *ContainerBackupJob, f func()) error {
+	err := b.update(j, func() {
+		f()
+		j.Running = false
+		delete(b.cancels, j.ID)
+	})
+
+	b.Lock()
+	defer b.Unlock()
+	r := *j
+	for ch := range b.watchers {
+		select {
+		case ch <- &r:
+		default:
+			log.Infof("backup job watcher full, drop job id=%v", j.ID)
+		}
+	}
+	return err
+}
+
+// next 取出最早加入的待执行任务
+func (b *backupJobManager) next() (*ContainerBackupJob, context.Context) {
+	var (
+		j       *ContainerBackupJob
+		ctx     context.Context
+		pending int
+	)
+	b.withLock(func() error {
+		for _, v := range b.m {
+			if v.Status != 0 || v.Running {
+				continue
+			}
+			pending++
+			if j == nil || v.ID < j.ID {
+				j = v
+			}
+		}
+		if j != nil {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(context.Background())
+			b.cancels[j.ID] = cancel
+			j.Running = true
+			j.UpdatedAt = time.Now().Unix()
+		}
 		return nil
 	})
+
+	// 还有待执行任务时继续唤醒其他工作协程
+	if pending > 1 {
+		b.notify()
+	}
+	return j, ctx
 }
 
 func (b *backupJobManager) get(id int64) (*ContainerBackupJob, error) {
 	var j *ContainerBackupJob
 	err := b.withLock(func() error {
-		j, _ = b.m[id]
+		if v, ok := b.m[id]; ok {
+			r := *v
+			j = &r
+		}
 		return nil
 	})
 
 	return j, err
 }
 
+func (b *backupJobManager) list() []*ContainerBackupJob {
+	var jobs []*ContainerBackupJob
+	b.withLock(func() error {
+		for _, v := range b.m {
+			r := *v
+			jobs = append(jobs, &r)
+		}
+		return nil
+	})
+	return jobs
+}
+
+func (b *backupJobManager) cancel(id int64) error {
+	var finished *ContainerBackupJob
+	err := b.withLock(func() error {
+		j, ok := b.m[id]
+		if !ok {
+			return ErrBackupJobNotFound
+		} else if j.Status != 0 {
+			return ErrBackupJobDone
+		}
+
+		// 执行中的任务由工作协程清理后结束
+		if cancel, ok := b.cancels[id]; ok {
+			cancel()
+			return nil
+		}
+		j.Status = 3
+		j.UpdatedAt = time.Now().Unix()
+		finished = j
+		return nil
+	})
+
+	if err == nil && finished != nil {
+		b.finish(finished, func() {})
+	}
+	return err
+}
+
 func (b *backupJobManager) del(id int64) error {
 	return b.withLock(func() error {
+		if cancel, ok := b.cancels[id]; ok {
+			cancel()
+		}
 		delete(b.m, id)
 		return nil
 	})
 }
 
+// requeue 节点重启前执行中的任务重新放回队列
+func (b *backupJobManager) requeue() error {
+	return b.withLock(func() error {
+		for _, j := range b.m {
+			if j.Status == 0 && j.Running {
+				j.Running = false
+				j.Progress = 0
+			}
+		}
+		return nil
+	})
+}
+
+func (b *backupJobManager) watch() (chan *ContainerBackupJob, func()) {
+	ch := make(chan *ContainerBackupJob, 100)
+	b.Lock()
+	b.watchers[ch] = true
+	b.Unlock()
+
+	return ch, func() {
+		b.Lock()
+		delete(b.watchers, ch)
+		b.Unlock()
+	}
+}
+
 type ContainerBackupJob struct {
 	ID          int64
 	ContainerID string
@@ -132,70 +299,228 @@ type ContainerBackupJob struct {
 	ImageRef    string
 	ImageID     string
 	ImageSize   int64
+	WithMounts  bool
+	Archives    []*BackupArchive
+	Progress    int64 // 已处理字节数
+	Total       int64 // 预计总字节数 容器可写层及挂载数据大小
+	Running     bool
+	Error       string
 	Status      int64
 	UpdatedAt   int64
 }
 
-func addBackupJob(j *ContainerBackupJob) {
+// CommitContainer 提交容器为镜像, 镜像沿用容器所用镜像的仓库名, tag为指定值
+func CommitContainer(containerID, tag string) (imageRef, imageID string, imageSize int64, err error) {
+	return commitContainer(context.Background(), containerID, tag)
+}
+
+func commitContainer(ctx context.Context, containerID, tag string) (imageRef, imageID string, imageSize int64, err error) {
 	cli, err := DockerClient()
 	if err != nil {
 		log.Warnf("create docker cli err=%v", err)
-		j.Status = 2
-		return
+		return "", "", 0, err
 	}
 
-	info, err := cli.ContainerInspect(context.Background(), j.ContainerID)
+	info, err := cli.ContainerInspect(ctx, containerID)
 	if err != nil {
-		log.Warnf("inspect container=%v err=%v", j.ContainerID, err)
-		j.Status = 2
-		return
+		log.Warnf("inspect container=%v err=%v", containerID, err)
+		return "", "", 0, err
 	}
 
 	if info.Config == nil {
-		log.Warnf("inspect container=%v .Config=nil", j.ContainerID)
-		j.Status = 2
-		return
+		log.Warnf("inspect container=%v .Config=nil", containerID)
+		return "", "", 0, fmt.Errorf("container=%v has no config", containerID)
 	}
 
-	j.ImageRef = strings.Split(info.Config.Image, ":")[0] + ":" + j.BackupName
-	newImage, err := cli.ContainerCommit(context.Background(), j.ContainerID, types.ContainerCommitOptions{
-		Reference: j.ImageRef,
+	// 提交失败时仍返回镜像名, 请求取消后docker可能继续完成提交
+	imageRef = strings.Split(info.Config.Image, ":")[0] + ":" + tag
+	newImage, err := cli.ContainerCommit(ctx, containerID, types.ContainerCommitOptions{
+		Reference: imageRef,
 		Pause:     true,
 	})
 	if err != nil {
-		log.Warnf("commit container=%v err=%v", j.ContainerID, err)
-		j.Status = 2
-		return
+		log.Warnf("commit container=%v err=%v", containerID, err)
+		return imageRef, "", 0, err
 	}
 
 	imageInfo, _, err := cli.ImageInspectWithRaw(context.Background(), newImage.ID)
 	if err != nil {
 		log.Warnf("inspect image=%v err=%v", newImage.ID, err)
-		j.Status = 2
-	} else {
-		log.Debugf("backup id=%v finished", j.ID)
-		j.ImageID = strings.TrimPrefix(newImage.ID, "sha256:")
-		j.ImageSize = imageInfo.Size
-		j.Status = 1
+		return "", "", 0, err
 	}
 
-	backup.update(j)
+	return imageRef, strings.TrimPrefix(newImage.ID, "sha256:"), imageInfo.Size, nil
 }
 
-func AddContainerBackupJob(id int64, containerID, name string) error {
-	job, err := backup.add(id, containerID, name)
+// 预计备份数据量 返回容器可写层大小及需要归档的挂载数据大小
+func backupJobSize(ctx context.Context, j *ContainerBackupJob) (int64, int64) {
+	cli, err := DockerClient()
 	if err != nil {
+		return 0, 0
+	}
+
+	info, _, err := cli.ContainerInspectWithRaw(ctx, j.ContainerID, true)
+	if err != nil {
+		log.Infof("inspect container=%v size err=%v", j.ContainerID, err)
+		return 0, 0
+	}
+
+	var rwSize, mountSize int64
+	if info.SizeRw != nil {
+		rwSize = *info.SizeRw
+	}
+	if j.WithMounts {
+		for _, m := range info.Mounts {
+			if archivableMount(m) {
+				mountSize += dirSize(m.Source)
+			}
+		}
+	}
+	return rwSize, mountSize
+}
+
+// 取消提交后docker可能稍后才完成提交 镜像不存在时重试
+const (
+	canceledImageRemoveAttempts = 6
+	canceledImageRemoveInterval = time.Second * 10
+)
+
+func removeCanceledImage(imageRef string) {
+	cli, err := DockerClient()
+	if err != nil {
+		return
+	}
+
+	for i := 0; i < canceledImageRemoveAttempts; i++ {
+		_, err := cli.ImageRemove(context.Background(), imageRef, types.ImageRemoveOptions{})
+		if err == nil {
+			return
+		} else if !client.IsErrNotFound(err) {
+			log.Infof("remove canceled backup image=%v err=%v", imageRef, err)
+			return
+		}
+		time.Sleep(canceledImageRemoveInterval)
+	}
+}
+
+// 清理取消的任务已生成的镜像及归档
+func cleanBackupJob(j *ContainerBackupJob, imageRef string) {
+	if imageRef != "" {
+		go removeCanceledImage(imageRef)
+	}
+	if j.WithMounts {
+		if err := RemoveBackupArchives(j.BackupName); err != nil {
+			log.Infof("remove canceled backup=%v archives err=%v", j.BackupName, err)
+		}
+	}
+}
+
+func runBackupJob(ctx context.Context, j *ContainerBackupJob) {
+	rwSize, mountSize := backupJobSize(ctx, j)
+	backup.update(j, func() {
+		j.Progress, j.Total = 0, rwSize+mountSize
+	})
+
+	imageRef, imageID, imageSize, err := commitContainer(ctx, j.ContainerID, j.BackupName)
+	if err == nil {
+		backup.update(j, func() {
+			j.ImageRef = imageRef
+			j.ImageID = imageID
+			j.ImageSize = imageSize
+			j.Progress += rwSize
+		})
+	}
+
+	var archives []*BackupArchive
+	if err == nil && j.WithMounts {
+		archives, err = ArchiveContainerMounts(ctx, j.ContainerID, j.BackupName, func(n int64) {
+			backup.addProgress(j, n)
+		})
+	}
+
+	if ctx.Err() != nil {
+		log.Infof("backup id=%v canceled", j.ID)
+		cleanBackupJob(j, imageRef)
+		backup.finish(j, func() {
+			j.ImageRef, j.ImageID, j.ImageSize = "", "", 0
+			j.Status = 3
+		})
+		return
+	} else if err != nil {
+		backup.finish(j, func() {
+			j.Error = err.Error()
+			j.Status = 2
+		})
+		return
+	}
+
+	log.Debugf("backup id=%v finished", j.ID)
+	backup.finish(j, func() {
+		j.Archives = archives
+		j.Progress = j.Total
+		j.Status = 1
+	})
+}
+
+func backupWorker() {
+	for {
+		j, ctx := backup.next()
+		if j == nil {
+			<-backup.wake
+			continue
+		}
+		runBackupJob(ctx, j)
+	}
+}
+
+// StartBackupWorkers 启动备份任务工作协程, 节点重启前执行中的任务重新执行
+func StartBackupWorkers(n int) {
+	backup.requeue()
+
+	if n <= 0 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		go backupWorker()
+	}
+	backup.notify()
+}
+
+func AddContainerBackupJob(id int64, containerID, name string, withMounts bool) error {
+	if _, err := backup.add(id, containerID, name, withMounts); err != nil {
 		log.Warnf("add backup job err=%v", err)
 		return err
 	}
 
-	go addBackupJob(job)
-
 	return nil
 }
 
 func GetContainerBackupJob(id int64) (*ContainerBackupJob, error) {
-	return backup.get(id)
+	j, err := backup.get(id)
+	if err == nil && j == nil {
+		return nil, ErrBackupJobNotFound
+	}
+	return j, err
+}
+
+// ListFinishedBackupJob 已结束但未被控制器删除的任务
+func ListFinishedBackupJob() []*ContainerBackupJob {
+	var jobs []*ContainerBackupJob
+	for _, j := range backup.list() {
+		if j.Status != 0 {
+			jobs = append(jobs, j)
+		}
+	}
+	return jobs
+}
+
+func CancelContainerBackupJob(id int64) error {
+	return backup.cancel(id)
+}
+
+// WatchContainerBackupJob 订阅结束的任务, 返回的函数用于取消订阅
+func WatchContainerBackupJob() (<-chan *ContainerBackupJob, func()) {
+	return backup.watch()
 }
 
 func DelContainerBackupJob(id int64) error {
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"scmc/common"
+	"testing"
+)
+
+func newTestBackupJobManager(t *testing.T) *backupJobManager {
+	dir, err := ioutil.TempDir("", "backup-job")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	old := common.Config.Agent.BackupJob
+	common.Config.Agent.BackupJob = filepath.Join(dir, "backup-job.json")
+	t.Cleanup(func() { common.Config.Agent.BackupJob = old })
+
+	return &backupJobManager{
+		cancels:  make(map[int64]context.CancelFunc),
+		watchers: make(map[chan *ContainerBackupJob]bool),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// 重新读取任务文件, 模拟节点重启
+func reloadBackupJobManager() *backupJobManager {
+	return &backupJobManager{
+		cancels:  make(map[int64]context.CancelFunc),
+		watchers: make(map[chan *ContainerBackupJob]bool),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+func TestBackupJobNext(t *testing.T) {
+	b := newTestBackupJobManager(t)
+	for _, id := range []int64{3, 1, 2} {
+		if _, err := b.add(id, "c", "name", false); err != nil {
+			t.Fatalf("add id=%v: %v", id, err)
+		}
+	}
+	if _, err := b.add(1, "c", "name", false); err == nil {
+		t.Errorf("add duplicate id=1: want error")
+	}
+
+	for _, want := range []int64{1, 2, 3} {
+		j, ctx := b.next()
+		if j == nil || j.ID != want {
+			t.Fatalf("next: got %+v, want id=%v", j, want)
+		}
+		if !j.Running || ctx == nil || b.cancels[j.ID] == nil {
+			t.Errorf("next id=%v: job not marked running", want)
+		}
+	}
+
+	if j, _ := b.next(); j != nil {
+		t.Errorf("next: got id=%v, want nil", j.ID)
+	}
+}
+
+func TestBackupJobCancel(t *testing.T) {
+	b := newTestBackupJobManager(t)
+	for _, id := range []int64{1, 2} {
+		if _, err := b.add(id, "c", "name", false); err != nil {
+			t.Fatalf("add id=%v: %v", id, err)
+		}
+	}
+	ch, stop := b.watch()
+	defer stop()
+
+	running, ctx := b.next()
+	if err := b.cancel(running.ID); err != nil {
+		t.Fatalf("cancel running: %v", err)
+	}
+	if ctx.Err() == nil {
+		t.Errorf("cancel running: context not canceled")
+	}
+	if running.Status != 0 {
+		t.Errorf("cancel running: status=%v, want 0 until worker finish", running.Status)
+	}
+
+	if err := b.cancel(2); err != nil {
+		t.Fatalf("cancel pending: %v", err)
+	}
+	select {
+	case j := <-ch:
+		if j.ID != 2 || j.Status != 3 {
+			t.Errorf("cancel pending: got id=%v status=%v", j.ID, j.Status)
+		}
+	default:
+		t.Errorf("cancel pending: watcher not notified")
+	}
+	if j, _ := b.next(); j != nil {
+		t.Errorf("next after cancel: got id=%v, want nil", j.ID)
+	}
+
+	tests := []struct {
+		id   int64
+		want error
+	}{
+		{2, ErrBackupJobDone},
+		{9, ErrBackupJobNotFound},
+	}
+	for _, tt := range tests {
+		if err := b.cancel(tt.id); err != tt.want {
+			t.Errorf("cancel id=%v: got %v, want %v", tt.id, err, tt.want)
+		}
+	}
+}
+
+func TestBackupJobFinish(t *testing.T) {
+	b := newTestBackupJobManager(t)
+	if _, err := b.add(1, "c", "name", false); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	ch, stop := b.watch()
+	defer stop()
+
+	j, _ := b.next()
+	if err := b.finish(j, func() {
+		j.ImageRef = "image:name"
+		j.Status = 1
+	}); err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	if j.Running || b.cancels[j.ID] != nil {
+		t.Errorf("finish: job still running")
+	}
+
+	select {
+	case r := <-ch:
+		if r.ID != 1 || r.Status != 1 || r.ImageRef != "image:name" {
+			t.Errorf("finish: watcher got %+v", r)
+		}
+	default:
+		t.Errorf("finish: watcher not notified")
+	}
+
+	saved, err := reloadBackupJobManager().get(1)
+	if err != nil || saved == nil {
+		t.Fatalf("get after reload: job=%v err=%v", saved, err)
+	}
+	if saved.Status != 1 || saved.ImageRef != "image:name" {
+		t.Errorf("get after reload: got %+v", saved)
+	}
+}
+
+func TestBackupJobRequeue(t *testing.T) {
+	b := newTestBackupJobManager(t)
+	for _, id := range []int64{1, 2} {
+		if _, err := b.add(id, "c", "name", false); err != nil {
+			t.Fatalf("add id=%v: %v", id, err)
+		}
+	}
+
+	j, _ := b.next()
+	b.update(j, func() { j.Progress = 100 })
+	done, _ := b.next()
+	b.finish(done, func() { done.Status = 1 })
+
+	r := reloadBackupJobManager()
+	if err := r.requeue(); err != nil {
+		t.Fatalf("requeue: %v", err)
+	}
+
+	got, _ := r.next()
+	if got == nil || got.ID != j.ID {
+		t.Fatalf("next after requeue: got %+v, want id=%v", got, j.ID)
+	}
+	if got.Progress != 0 {
+		t.Errorf("next after requeue: progress=%v, want 0", got.Progress)
+	}
+	if j, _ := r.next(); j != nil {
+		t.Errorf("next after requeue: got id=%v, want nil", j.ID)
+	}
+}
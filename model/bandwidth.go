@@ -0,0 +1,111 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"scmc/common"
+)
+
+// 容器网卡带宽限制 以docker网络名区分
+type BandwidthLimit struct {
+	Network     string `json:"network"`
+	IngressRate int64  `json:"ingress_rate"` // 单位kbit/s
+	EgressRate  int64  `json:"egress_rate"`  // 单位kbit/s
+}
+
+func bandwidthFile(containerID string) string {
+	return filepath.Join(common.Config.Network.BandwidthPath, containerID+".json")
+}
+
+func SaveContainerBandwidth(containerID string, limits []BandwidthLimit) error {
+	if len(limits) == 0 {
+		return RemoveContainerBandwidth(containerID)
+	}
+
+	if err := os.MkdirAll(common.Config.Network.BandwidthPath, 0755); err != nil {
+		log.Warnf("mkdir %v: %v", common.Config.Network.BandwidthPath, err)
+		return err
+	}
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(bandwidthFile(containerID), data, 0644)
+}
+
+func LoadContainerBandwidth(containerID string) ([]BandwidthLimit, error) {
+	data, err := ioutil.ReadFile(bandwidthFile(containerID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var limits []BandwidthLimit
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func RemoveContainerBandwidth(containerID string) error {
+	if err := os.Remove(bandwidthFile(containerID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// 根据MAC地址查找容器网络命名空间内的网卡名
+func containerIfname(pid int, mac string) (string, error) {
+	out, err := callShell(fmt.Sprintf("nsenter -t %d -n ip -o link", pid))
+	if err != nil {
+		return "", err
+	}
+
+	// 2: eth0@if5: <BROADCAST,MULTICAST,UP,LOWER_UP> ... link/ether 02:42:ac:11:00:02 brd ...
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.Contains(strings.ToLower(line), "link/ether "+strings.ToLower(mac)) {
+			continue
+		}
+		return strings.SplitN(strings.TrimSuffix(fields[1], ":"), "@", 2)[0], nil
+	}
+	return "", fmt.Errorf("interface mac=%s not found", mac)
+}
+
+// 在容器网络命名空间内通过tc限制网卡带宽 出站使用HTB 入站使用ingress policing
+func SetContainerBandwidth(pid int, mac string, ingressRate, egressRate int64) error {
+	ifname, err := containerIfname(pid, mac)
+	if err != nil {
+		return err
+	}
+
+	tc := fmt.Sprintf("nsenter -t %d -n tc", pid)
+	cmd := fmt.Sprintf("%s qdisc del dev %s root 2>/dev/null; %s qdisc del dev %s ingress 2>/dev/null; true", tc, ifname, tc, ifname)
+	if egressRate > 0 {
+		cmd = fmt.Sprintf("%s && %s qdisc add dev %s root handle 1: htb default 10 && %s class add dev %s parent 1: classid 1:10 htb rate %dkbit",
+			cmd, tc, ifname, tc, ifname, egressRate)
+	}
+	if ingressRate > 0 {
+		burst := ingressRate / 80 // 约100ms流量 单位KB
+		if burst < 10 {
+			burst = 10
+		}
+		cmd = fmt.Sprintf("%s && %s qdisc add dev %s handle ffff: ingress && %s filter add dev %s parent ffff: protocol all u32 match u32 0 0 police rate %dkbit burst %dk drop flowid :1",
+			cmd, tc, ifname, tc, ifname, ingressRate, burst)
+	}
+
+	if _, err := callShell(cmd); err != nil {
+		return err
+	}
+	return nil
+}
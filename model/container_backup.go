@@ -12,21 +12,26 @@ var (
 )
 
 type ContainerBackup struct {
-	ID          int64 `gorm:"primaryKey"`
-	NodeID      int64
-	UUID        string
-	ContainerID string
-	BackupName  string
-	BackupDesc  string
-	ImageRef    string
-	ImageID     string
-	ImageSize   int64
-	Status      int8  // 0:备份中 1:成功 2:失败
-	CreatedAt   int64 `gorm:"autoCreateTime"`
-	UpdatedAt   int64 `gorm:"autoUpdateTime"`
+	ID           int64 `gorm:"primaryKey"`
+	NodeID       int64
+	UUID         string
+	ContainerID  string
+	BackupName   string
+	BackupDesc   string
+	ImageRef     string
+	ImageID      string
+	ImageSize    int64
+	Status       int8   // 0:备份中 1:成功 2:失败
+	ScheduleID   int64  // 定时备份ID 手动备份为0
+	RegistryRef  string // 仓库中的备份镜像 为空表示未推送
+	PushAttempts int64  // 推送至仓库的尝试次数
+	Archives     string // 挂载数据归档列表 JSON
+	ArchiveSize  int64  // 挂载数据归档总大小
+	CreatedAt    int64  `gorm:"autoCreateTime"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime"`
 }
 
-func CreateContainerBackup(nodeID int64, uuid string, backupName, backupDesc string) (*ContainerBackup, error) {
+func CreateContainerBackup(nodeID int64, uuid string, backupName, backupDesc string, scheduleID int64) (*ContainerBackup, error) {
 	db, err := getConn()
 	if err != nil {
 		return nil, err
@@ -37,6 +42,7 @@ func CreateContainerBackup(nodeID int64, uuid string, backupName, backupDesc str
 		UUID:       uuid,
 		BackupName: backupName,
 		BackupDesc: backupDesc,
+		ScheduleID: scheduleID,
 	}
 
 	if err := db.Create(&data).Error; err != nil {
@@ -120,6 +126,22 @@ func QueryContainerBackupByUUID(uuid string) ([]*ContainerBackup, error) {
 	return data, nil
 }
 
+// QueryLatestContainerBackup 查询容器最近一次成功的备份
+func QueryLatestContainerBackup(uuid string) (*ContainerBackup, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data ContainerBackup
+	if err := db.Where("uuid = ? AND status = 1", uuid).Order("created_at DESC").First(&data).Error; err != nil {
+		log.Infof("QueryLatestContainerBackup uuid=%v err=%v", uuid, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
+
 func QueryUndoneContainerBackup() ([]*ContainerBackup, error) {
 	db, err := getConn()
 	if err != nil {
@@ -149,3 +171,66 @@ func ListContainerBackup() ([]*ContainerBackup, error) {
 
 	return data, nil
 }
+
+// QueryScheduledContainerBackup 查询定时备份产生的已结束备份 按创建时间倒序
+func QueryScheduledContainerBackup(scheduleID int64) ([]*ContainerBackup, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*ContainerBackup
+	if err := db.Where("schedule_id = ? AND status <> 0", scheduleID).Order("created_at DESC, id DESC").Find(&data).Error; err != nil {
+		log.Warnf("QueryScheduledContainerBackup schedule_id=%v err=%v", scheduleID, err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
+
+// UpdateContainerBackupPush 只更新推送结果 避免覆盖其他字段
+func UpdateContainerBackupPush(data *ContainerBackup) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Model(data).Select("registry_ref", "push_attempts").Updates(data).Error; err != nil {
+		log.Errorf("db update container backup push %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// QueryUnpushedContainerBackup 查询成功但未推送至仓库的备份
+func QueryUnpushedContainerBackup(maxAttempts int64) ([]*ContainerBackup, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*ContainerBackup
+	if err := db.Where("status = 1 AND registry_ref = '' AND push_attempts < ?", maxAttempts).Order("id").Find(&data).Error; err != nil {
+		log.Warnf("QueryUnpushedContainerBackup err=%v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
+
+// CountContainerBackupByRegistryRef 查询使用同一仓库镜像的备份数 在其他节点恢复后的备份共用仓库镜像
+func CountContainerBackupByRegistryRef(registryRef string) (int64, error) {
+	db, err := getConn()
+	if err != nil {
+		return 0, err
+	}
+
+	var cnt int64
+	if err := db.Model(&ContainerBackup{}).Where("registry_ref = ?", registryRef).Count(&cnt).Error; err != nil {
+		log.Warnf("CountContainerBackupByRegistryRef err=%v", err)
+		return 0, translateError(err)
+	}
+
+	return cnt, nil
+}
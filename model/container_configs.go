@@ -5,23 +5,35 @@ import (
 )
 
 type ContainerConfigs struct {
-	ID             int64 `gorm:"primaryKey"`
-	NodeID         int64
-	UUID           string
-	ContainerID    string
-	ContainerName  string
-	SecurityConfig string
-	CreatedAt      int64 `gorm:"autoCreateTime"`
-	UpdatedAt      int64 `gorm:"autoUpdateTime"`
+	ID              int64 `gorm:"primaryKey"`
+	NodeID          int64
+	UUID            string
+	ContainerID     string
+	ContainerName   string
+	SecurityConfig  string
+	Configs         string // 容器配置JSON 用于故障转移重建
+	HaEnabled       bool
+	Creator         int64  // 创建者用户ID
+	SharedUsers     string // 共享用户ID 逗号分隔
+	TemplateID      int64  // 使用模板创建时的模板ID
+	TemplateVersion int64  // 使用模板创建时的模板版本
+	CreatedAt       int64  `gorm:"autoCreateTime"`
+	UpdatedAt       int64  `gorm:"autoUpdateTime"`
 }
 
 type SecurityConfigs struct {
-	DisableExternalNetwork bool             `json:"disable_external_network,omitempty"` // 禁止访问外部网络
-	DisableCmdOperation    bool             `json:"disable_cmd_operation,omitempty"`    // 禁止命令行控制容器(启停控制)
-	ProcProtection         *ProcProtection  `json:"proc_protection,omitempty"`          // 进程保护
-	NprocProtection        *ProcProtection  `json:"nproc_protection,omitempty"`         // 网络进程保护
-	FileProtection         *FileProtection  `json:"file_protection,omitempty"`          // 文件防篡改保护
-	NetworkRule            *NetworkRuleList `json:"network_rule,omitempty"`             // 网络访问规则
+	DisableExternalNetwork bool              `json:"disable_external_network,omitempty"` // 禁止访问外部网络
+	DisableCmdOperation    bool              `json:"disable_cmd_operation,omitempty"`    // 禁止命令行控制容器(启停控制)
+	ProcProtection         *ProcProtection   `json:"proc_protection,omitempty"`          // 进程保护
+	NprocProtection        *ProcProtection   `json:"nproc_protection,omitempty"`         // 网络进程保护
+	FileProtection         *FileProtection   `json:"file_protection,omitempty"`          // 文件防篡改保护
+	NetworkRule            *NetworkRuleList  `json:"network_rule,omitempty"`             // 网络访问规则
+	NoNewPrivileges        bool              `json:"no_new_privileges,omitempty"`        // 禁止容器进程获取新权限
+	ReadOnlyRootfs         bool              `json:"read_only_rootfs,omitempty"`         // 只读根文件系统
+	SeccompProfile         string            `json:"seccomp_profile,omitempty"`          // seccomp配置名
+	CapAdd                 []string          `json:"cap_add,omitempty"`                  // 增加的capabilities
+	CapDrop                []string          `json:"cap_drop,omitempty"`                 // 去除的capabilities
+	Tmpfs                  map[string]string `json:"tmpfs,omitempty"`                    // tmpfs挂载
 }
 
 type ProcProtection struct {
@@ -142,6 +154,22 @@ func ListContainerConfigs() ([]ContainerConfigs, error) {
 	return datas, nil
 }
 
+func ListHAContainerConfigs(nodeID int64) ([]*ContainerConfigs, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var datas []*ContainerConfigs
+	result := db.Find(&datas, "node_id = ? AND ha_enabled = 1", nodeID)
+	if result.Error != nil {
+		log.Errorf("db query ha container configs node_id=%v: %v", nodeID, result.Error)
+		return nil, translateError(result.Error)
+	}
+
+	return datas, nil
+}
+
 func RemoveContainerConfigs(nodeID int64, containerID []string) error {
 	db, err := getConn()
 	if err != nil {
@@ -0,0 +1,68 @@
+package model
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ImportWaitApproval = 0 // 等待镜像审批
+	ImportSucceed      = 1 // 已创建容器
+	ImportFailed       = 2 // 失败
+)
+
+type ContainerImport struct {
+	ID          int64 `gorm:"primaryKey"`
+	ImageID     int64
+	NodeID      int64
+	Configs     string // 容器配置 以JSON形式存储
+	Status      int8
+	ContainerID string
+	Attempts    int64 // 创建容器尝试次数
+	Reason      string
+	Creator     int64 // 导入者用户ID
+	CreatedAt   int64 `gorm:"autoCreateTime"`
+	UpdatedAt   int64 `gorm:"autoUpdateTime"`
+}
+
+func CreateContainerImport(data *ContainerImport) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(data).Error; err != nil {
+		log.Errorf("db create container import %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func UpdateContainerImport(data *ContainerImport) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Save(data).Error; err != nil {
+		log.Errorf("db update container import %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func ListPendingContainerImports() ([]*ContainerImport, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*ContainerImport
+	if err := db.Where("status = ?", ImportWaitApproval).Find(&data).Error; err != nil {
+		log.Errorf("db list container imports %v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
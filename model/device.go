@@ -0,0 +1,52 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// 禁止直通的主机设备, 节点允许列表中也不能配置
+var deniedDevices = []string{"/dev/mem", "/dev/kmem", "/dev/port"}
+
+// IsDeniedDevice 路径或通配符匹配禁止直通的设备
+func IsDeniedDevice(path string) bool {
+	for _, d := range deniedDevices {
+		if ok, _ := filepath.Match(path, d); ok || path == d {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeviceAllowed 设备路径在节点允许列表中且不是禁止直通的设备
+func IsDeviceAllowed(allowlist []string, path string) bool {
+	path = filepath.Clean(path)
+	if IsDeniedDevice(path) {
+		return false
+	}
+
+	for _, p := range allowlist {
+		if ok, _ := filepath.Match(p, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveDevice 解析设备路径中的符号链接, 目标须为字符或块设备
+// docker按解析后的路径直通设备, 允许列表中的 /dev/char/* 等链接可能指向禁止直通的设备
+func ResolveDevice(path string) (string, error) {
+	r, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+
+	fi, err := os.Stat(r)
+	if err != nil {
+		return "", err
+	} else if fi.Mode()&os.ModeDevice == 0 {
+		return "", fmt.Errorf("%s is not a device", r)
+	}
+	return r, nil
+}
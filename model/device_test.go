@@ -0,0 +1,81 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsDeviceAllowed(t *testing.T) {
+	allowlist := []string{"/dev/ttyUSB*", "/dev/char/*", "/dev/sdb"}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/dev/ttyUSB0", true},
+		{"/dev/sdb", true},
+		{"/dev/sdb1", false},
+		{"/dev/char/188:0", true},
+		{"/dev/ttyUSB0/../mem", false},
+		{"/dev/mem", false},
+		{"/dev/sda", false},
+	}
+	for _, tt := range tests {
+		if got := IsDeviceAllowed(allowlist, tt.path); got != tt.want {
+			t.Errorf("IsDeviceAllowed(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	if IsDeviceAllowed([]string{"/dev/*"}, "/dev/kmem") {
+		t.Errorf("IsDeviceAllowed(/dev/*, /dev/kmem) = true, want false")
+	}
+}
+
+func TestIsDeniedDevice(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/dev/mem", true},
+		{"/dev/port", true},
+		{"/dev/*", true},
+		{"/dev/k*", true},
+		{"/dev/null", false},
+		{"/dev/tty*", false},
+	}
+	for _, tt := range tests {
+		if got := IsDeniedDevice(tt.path); got != tt.want {
+			t.Errorf("IsDeniedDevice(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestResolveDevice(t *testing.T) {
+	if _, err := os.Stat("/dev/null"); err != nil {
+		t.Skip("/dev/null not available")
+	}
+
+	dir := tempDir(t)
+	link := filepath.Join(dir, "null")
+	dirLink := filepath.Join(dir, "dir")
+	os.Symlink("/dev/null", link)
+	os.Symlink(dir, dirLink)
+
+	tests := []struct {
+		path string
+		want string
+		ok   bool
+	}{
+		{"/dev/null", "/dev/null", true},
+		{link, "/dev/null", true},
+		{dir, "", false},
+		{dirLink, "", false},
+		{filepath.Join(dir, "missing"), "", false},
+	}
+	for _, tt := range tests {
+		got, err := ResolveDevice(tt.path)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("ResolveDevice(%q) = %q, %v, want %q ok=%v", tt.path, got, err, tt.want, tt.ok)
+		}
+	}
+}
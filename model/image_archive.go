@@ -0,0 +1,130 @@
+package model
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"runtime"
+	"time"
+)
+
+// 镜像运行配置 docker export导出的根文件系统不包含这部分信息
+type ImageRuntimeConfig struct {
+	Cmd        []string `json:"Cmd,omitempty"`
+	Entrypoint []string `json:"Entrypoint,omitempty"`
+	Env        []string `json:"Env,omitempty"`
+	WorkingDir string   `json:"WorkingDir,omitempty"`
+	User       string   `json:"User,omitempty"`
+}
+
+type imageArchiveConfig struct {
+	Architecture string             `json:"architecture"`
+	OS           string             `json:"os"`
+	Created      string             `json:"created"`
+	Config       ImageRuntimeConfig `json:"config"`
+	RootFS       struct {
+		Type    string   `json:"type"`
+		DiffIDs []string `json:"diff_ids"`
+	} `json:"rootfs"`
+	History []map[string]string `json:"history"`
+}
+
+// FileSha256 计算文件的sha256及大小
+func FileSha256(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func writeTarFile(w *tar.Writer, name string, size int64, r io.Reader) error {
+	hdr := tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: time.Now(),
+	}
+	if err := w.WriteHeader(&hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(w, r)
+	return err
+}
+
+// BuildImageArchive 将docker export导出的根文件系统打包为docker save格式的单层镜像文件
+// 镜像ID即配置文件的sha256, 与docker load结果一致
+func BuildImageArchive(rootfsTar, dst, repoTag string, cfg ImageRuntimeConfig) (string, error) {
+	diffID, layerSize, err := FileSha256(rootfsTar)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	config := imageArchiveConfig{
+		Architecture: runtime.GOARCH,
+		OS:           "linux",
+		Created:      now,
+		Config:       cfg,
+		History:      []map[string]string{{"created": now, "comment": "imported from container export"}},
+	}
+	config.RootFS.Type = "layers"
+	config.RootFS.DiffIDs = []string{"sha256:" + diffID}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	configSum := sha256.Sum256(configData)
+	imageID := hex.EncodeToString(configSum[:])
+
+	layerPath := diffID + "/layer.tar"
+	manifestData, err := json.Marshal([]manifest{{
+		Config:   imageID + ".json",
+		RepoTags: []string{repoTag},
+		Layers:   []string{layerPath},
+	}})
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	layer, err := os.Open(rootfsTar)
+	if err != nil {
+		return "", err
+	}
+	defer layer.Close()
+
+	// 配置文件写在最前, 镜像ID从首个非manifest的json文件名获取
+	w := tar.NewWriter(f)
+	if err := writeTarFile(w, imageID+".json", int64(len(configData)), bytes.NewReader(configData)); err != nil {
+		return "", err
+	} else if err := w.WriteHeader(&tar.Header{Name: diffID + "/", Mode: 0755, Typeflag: tar.TypeDir, ModTime: time.Now()}); err != nil {
+		return "", err
+	} else if err := writeTarFile(w, layerPath, layerSize, layer); err != nil {
+		return "", err
+	} else if err := writeTarFile(w, "manifest.json", int64(len(manifestData)), bytes.NewReader(manifestData)); err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return imageID, nil
+}
@@ -6,17 +6,18 @@ import (
 )
 
 type NodeInfo struct {
-	ID          int64 `gorm:"primaryKey"`
-	Name        string
-	Address     string
-	Comment     string
-	UnreadWarn  int64
-	CpuLimit    float64
-	MemoryLimit float64
-	DiskLimit   float64
-	Deleted     bool
-	CreatedAt   int64 `gorm:"autoCreateTime"`
-	UpdatedAt   int64 `gorm:"autoUpdateTime"`
+	ID             int64 `gorm:"primaryKey"`
+	Name           string
+	Address        string
+	Comment        string
+	UnreadWarn     int64
+	CpuLimit       float64
+	MemoryLimit    float64
+	DiskLimit      float64
+	Deleted        bool
+	AllowedDevices string // 允许直通到容器的主机设备路径 逗号分隔
+	CreatedAt      int64  `gorm:"autoCreateTime"`
+	UpdatedAt      int64  `gorm:"autoUpdateTime"`
 }
 
 func (NodeInfo) TableName() string {
@@ -30,6 +30,14 @@ import (
 // pushing with the prefix, *docker images* shows simplified repo name after pull.
 const imageRepoPrefix = "library/"
 
+// 备份镜像在仓库中的命名空间 与上传的镜像区分
+const backupRepoNamespace = "scmc-backup/"
+
+// BackupRegistryRef 备份镜像在仓库中的repo:tag 按容器UUID区分仓库
+func BackupRegistryRef(uuid, backupName string) string {
+	return backupRepoNamespace + uuid + ":" + backupName
+}
+
 func registryUrl() string {
 	if common.Config.Registry.Secure {
 		return "https://" + common.Config.Registry.Addr
@@ -140,13 +148,17 @@ type manifest struct {
 }
 
 type imagePush struct {
-	repo    string
-	tag     string
-	tarFile string
-	hub     *registryClient
+	namespace string // 为空时使用imageRepoPrefix
+	repo      string
+	tag       string
+	tarFile   string
+	hub       *registryClient
 }
 
 func (m *imagePush) fullRepo() string {
+	if m.namespace != "" {
+		return m.namespace + m.repo
+	}
 	return imageRepoPrefix + m.repo
 }
 
@@ -251,13 +263,13 @@ func (m *imagePush) handle(baseDir string, man *manifest) error {
 	return nil
 }
 
-func (m *imagePush) push() {
+func (m *imagePush) push() error {
 	// create tmp dir for tar unpack
 	dir := fmt.Sprintf("%s:%s-%d", m.repo, m.tag, time.Now().UnixNano())
 	tmpDir, err := ioutil.TempDir(os.TempDir(), dir)
 	if err != nil {
 		log.Warnf("ioutil.TempDir %s err=%v", tmpDir, err)
-		return
+		return err
 	}
 	defer os.RemoveAll(tmpDir)
 
@@ -265,19 +277,19 @@ func (m *imagePush) push() {
 	arch := archive.NewDefaultArchiver()
 	if err := arch.UntarPath(m.tarFile, tmpDir); err != nil {
 		log.Warnf("Untar src=%s dst=%s err=%v", m.tarFile, tmpDir, err)
-		return
+		return err
 	}
 
 	manifests, err := m.parseManifest(tmpDir + "/manifest.json")
 	if err != nil {
 		log.Warnf("parseManifest dir=%s err=%v", tmpDir, err)
-		return
+		return err
 	}
 	if len(manifests) < 1 {
 		log.Warnf("no valid manifest")
-		return
+		return fmt.Errorf("no valid manifest in %s", m.tarFile)
 	}
-	m.handle(tmpDir, manifests[0])
+	return m.handle(tmpDir, manifests[0])
 }
 
 func pullImage(cli *client.Client, repoTag string) (io.ReadCloser, error) {
@@ -404,6 +416,85 @@ func PushImage(info *ImageInfo) {
 	p.push()
 }
 
+// PushLocalImage 将本地docker镜像导出后推送至registry, 用于容器迁移
+func PushLocalImage(repoTag string) error {
+	n := strings.LastIndex(repoTag, ":")
+	if n == -1 {
+		return fmt.Errorf("invalid image repotag=%s", repoTag)
+	}
+
+	return pushLocalImage(repoTag, imagePush{repo: repoTag[:n], tag: repoTag[n+1:]})
+}
+
+// PushBackupImage 推送本地备份镜像至仓库的备份命名空间
+func PushBackupImage(imageRef, registryRef string) error {
+	n := strings.LastIndex(registryRef, ":")
+	if n == -1 || !strings.HasPrefix(registryRef, backupRepoNamespace) {
+		return fmt.Errorf("invalid backup registry ref=%s", registryRef)
+	}
+
+	return pushLocalImage(imageRef, imagePush{
+		namespace: backupRepoNamespace,
+		repo:      registryRef[len(backupRepoNamespace):n],
+		tag:       registryRef[n+1:],
+	})
+}
+
+// PullBackupImage 从仓库拉取备份镜像 并按备份时的镜像名打tag
+func PullBackupImage(registryRef, imageRef string) error {
+	if err := PullImage(registryRef); err != nil {
+		return err
+	}
+
+	cli, err := DockerClient()
+	if err != nil {
+		return err
+	}
+
+	if err := cli.ImageTag(context.Background(), registryRef, imageRef); err != nil {
+		log.Warnf("tag image=%s as %s err=%v", registryRef, imageRef, err)
+		return err
+	}
+	return nil
+}
+
+func pushLocalImage(repoTag string, p imagePush) error {
+	cli, err := DockerClient()
+	if err != nil {
+		return err
+	}
+
+	hub, err := newRegistryClient()
+	if err != nil {
+		log.Warnf("connect to registry %s err=%v", registryUrl(), err)
+		return err
+	}
+
+	reader, err := cli.ImageSave(context.Background(), []string{repoTag})
+	if err != nil {
+		log.Warnf("save image=%s err=%v", repoTag, err)
+		return err
+	}
+	defer reader.Close()
+
+	f, err := ioutil.TempFile(os.TempDir(), "scmc-image-*.tar")
+	if err != nil {
+		log.Warnf("create temp file err=%v", err)
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		log.Warnf("write image=%s to file=%s err=%v", repoTag, f.Name(), err)
+		return err
+	}
+
+	p.tarFile = f.Name()
+	p.hub = hub
+	return p.push()
+}
+
 func PushImgae() {
 	for {
 		CheckImagePush()
@@ -472,6 +563,27 @@ func RemoveRegistryImage(repoTag string) error {
 		return fmt.Errorf("invalid image repotag=%s", repoTag)
 	}
 
+	return removeRegistryManifest(hub, repo, tag)
+}
+
+// RemovePushedLocalImage 删除PushLocalImage推送至registry的镜像
+func RemovePushedLocalImage(repoTag string) error {
+	n := strings.LastIndex(repoTag, ":")
+	if n == -1 {
+		return fmt.Errorf("invalid image repotag=%s", repoTag)
+	}
+
+	hub, err := newRegistryClient()
+	if err != nil {
+		log.Warnf("connect to registry %s err=%v", registryUrl(), err)
+		return err
+	}
+
+	p := imagePush{repo: repoTag[:n], tag: repoTag[n+1:]}
+	return removeRegistryManifest(hub, p.fullRepo(), p.tag)
+}
+
+func removeRegistryManifest(hub *registryClient, repo, tag string) error {
 	d, err := hub.ManifestDigest(repo, tag)
 	if err != nil {
 		return err
@@ -479,7 +591,7 @@ func RemoveRegistryImage(repoTag string) error {
 
 	err = hub.DeleteManifest(repo, d)
 	if err != nil {
-		log.Warnf("registry client DeleteManifest image=%s digest=%s err=%v", repoTag, d, err)
+		log.Warnf("registry client DeleteManifest image=%s:%s digest=%s err=%v", repo, tag, d, err)
 		return err
 	}
 
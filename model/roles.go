@@ -13,6 +13,8 @@ type UserRole struct {
 	PermsJSON  string
 	CreatedAt  int64 `gorm:"autoCreateTime"`
 	UpdatedAt  int64 `gorm:"autoCreateTime"`
+
+	OwnedContainersOnly bool // 仅可访问自己创建或共享给自己的容器
 }
 
 func QueryRoleByName(ctx context.Context, roleName string) (*UserRole, error) {
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type SeccompProfile struct {
+	ID        int64 `gorm:"primaryKey"`
+	Name      string
+	Content   string // docker seccomp配置JSON
+	Desc      string
+	CreatedAt int64 `gorm:"autoCreateTime"`
+	UpdatedAt int64 `gorm:"autoUpdateTime"`
+}
+
+func ListSeccompProfiles() ([]*SeccompProfile, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*SeccompProfile
+	if err := db.Find(&data).Error; err != nil {
+		log.Errorf("db list seccomp profiles %v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
+
+func GetSeccompProfile(id int64) (*SeccompProfile, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data SeccompProfile
+	if err := db.First(&data, "id = ?", id).Error; err != nil {
+		log.Infof("db get seccomp profile id=%v err=%v", id, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
+
+func GetSeccompProfileByName(name string) (*SeccompProfile, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data SeccompProfile
+	if err := db.First(&data, "name = ?", name).Error; err != nil {
+		log.Infof("db get seccomp profile name=%v err=%v", name, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
+
+func CreateSeccompProfile(data *SeccompProfile) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(data).Error; err != nil {
+		log.Errorf("db create seccomp profile %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func UpdateSeccompProfile(data *SeccompProfile) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Save(data).Error; err != nil {
+		log.Errorf("db update seccomp profile %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func RemoveSeccompProfiles(ids []int64) error {
+	db, err := getConn()
+	if err != nil {
+		return err
+	}
+
+	if err := db.Delete(&SeccompProfile{}, ids).Error; err != nil {
+		log.Errorf("db remove seccomp profiles %v", err)
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// 使用该seccomp配置的容器数量
+func CountSeccompProfileUsage(name string) (int64, error) {
+	db, err := getConn()
+	if err != nil {
+		return 0, err
+	}
+
+	// 按JSON编码后的名称精确匹配, 名称中的通配符需转义
+	quoted, err := json.Marshal(name)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	pattern := fmt.Sprintf("%%\"seccomp_profile\":%s%%", likeEscape(string(quoted)))
+	if err := db.Model(&ContainerConfigs{}).Where("security_config LIKE ?", pattern).Count(&count).Error; err != nil {
+		log.Errorf("db count seccomp profile usage %v", err)
+		return 0, translateError(err)
+	}
+
+	return count, nil
+}
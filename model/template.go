@@ -14,10 +14,22 @@ type ContainerTemplates struct {
 	Name       string
 	ConfigJSON string
 	NodeId     int64
+	Version    int64 // 模板版本 每次更新加1
 	CreatedAt  int64 `gorm:"autoCreateTime"`
 	UpdatedAt  int64 `gorm:"autoUpdateTime"`
 }
 
+// 模板历史版本 每次创建或更新模板时保存
+type ContainerTemplateRevisions struct {
+	Id         int64 `gorm:"primaryKey"`
+	TemplateId int64
+	Version    int64
+	Name       string
+	NodeId     int64
+	ConfigJSON string
+	CreatedAt  int64 `gorm:"autoCreateTime"`
+}
+
 type PageInfo struct {
 	PerPage    int64
 	CurPage    int64
@@ -135,51 +147,148 @@ func CreateTemplate(ctx context.Context, id int64, name string, configbyte []byt
 		Name:       name,
 		ConfigJSON: string(configbyte),
 		NodeId:     nodeId,
+		Version:    1,
 	}
-	if result := db.WithContext(ctx).Create(&templateInfo); result.Error != nil {
-		log.Errorf("db create template %v", result.Error)
-		return -1, translateError(result.Error)
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createTemplate(tx, &templateInfo)
+	})
+	if err != nil {
+		return -1, err
 	}
 
 	return templateInfo.Id, nil
 }
 
-func UpdateTemplate(ctx context.Context, id int64, name string, configbyte []byte, nodeId int64) error {
+func createTemplate(tx *gorm.DB, t *ContainerTemplates) error {
+	if err := tx.Create(t).Error; err != nil {
+		log.Errorf("db create template %v", err)
+		return translateError(err)
+	}
+	return createTemplateRevision(tx, t)
+}
+
+// 更新模板 返回更新后的版本
+func UpdateTemplate(ctx context.Context, id int64, name string, configbyte []byte, nodeId int64) (int64, error) {
 	db, err := getConn()
 	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		version, err = updateTemplate(tx, id, name, configbyte, nodeId)
 		return err
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	// templateInfo := ContainerTemplates{}
+	return version, nil
+}
 
-	if result := db.WithContext(ctx).Model(&ContainerTemplates{}).Where("id=?", id).Updates(
+func updateTemplate(tx *gorm.DB, id int64, name string, configbyte []byte, nodeId int64) (int64, error) {
+	var data ContainerTemplates
+	if err := tx.First(&data, "id = ?", id).Error; err != nil {
+		log.Warnf("db find template id=%v err=%v", id, err)
+		return 0, translateError(err)
+	}
+
+	// 早于版本记录创建的模板 先保存当前版本
+	var cnt int64
+	if err := tx.Model(&ContainerTemplateRevisions{}).Where("template_id = ? AND version = ?", id, data.Version).Count(&cnt).Error; err != nil {
+		log.Errorf("db count template revision %v", err)
+		return 0, translateError(err)
+	} else if cnt == 0 {
+		if err := createTemplateRevision(tx, &data); err != nil {
+			return 0, err
+		}
+	}
+
+	data.Name = name
+	data.ConfigJSON = string(configbyte)
+	data.NodeId = nodeId
+	data.Version++
+	if result := tx.Model(&ContainerTemplates{}).Where("id=?", id).Updates(
 		map[string]interface{}{
-			"name":        name,
-			"config_json": string(configbyte),
-			"node_id":     nodeId,
+			"name":        data.Name,
+			"config_json": data.ConfigJSON,
+			"node_id":     data.NodeId,
+			"version":     data.Version,
 		},
 	); result.Error != nil {
 		log.Errorf("db update template %v", result.Error)
-		return translateError(result.Error)
+		return 0, translateError(result.Error)
 	}
 
-	return nil
+	return data.Version, createTemplateRevision(tx, &data)
 }
 
-func RemoveTemplate(ctx context.Context, ids []int64) error {
+// 导入的模板 Id大于0时覆盖已有模板
+type TemplateImport struct {
+	Id         int64
+	Name       string
+	ConfigJSON []byte
+	NodeId     int64
+}
+
+// ImportTemplates 在同一事务中创建或覆盖模板, 任一失败时全部回滚, 返回模板ID
+func ImportTemplates(ctx context.Context, items []*TemplateImport) ([]int64, error) {
 	db, err := getConn()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var containerTemplates ContainerTemplates
+	var ids []int64
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if item.Id > 0 {
+				if _, err := updateTemplate(tx, item.Id, item.Name, item.ConfigJSON, item.NodeId); err != nil {
+					return err
+				}
+				ids = append(ids, item.Id)
+				continue
+			}
+
+			t := ContainerTemplates{
+				Name:       item.Name,
+				ConfigJSON: string(item.ConfigJSON),
+				NodeId:     item.NodeId,
+				Version:    1,
+			}
+			if err := createTemplate(tx, &t); err != nil {
+				return err
+			}
+			ids = append(ids, t.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
 
-	if result := db.WithContext(ctx).Delete(&containerTemplates, ids); result.Error != nil {
-		log.Errorf("db delete template %v", result.Error)
-		return translateError(result.Error)
+func RemoveTemplate(ctx context.Context, ids []int64) error {
+	db, err := getConn()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var containerTemplates ContainerTemplates
+		if result := tx.Delete(&containerTemplates, ids); result.Error != nil {
+			log.Errorf("db delete template %v", result.Error)
+			return translateError(result.Error)
+		}
+
+		if err := tx.Where("template_id IN ?", ids).Delete(&ContainerTemplateRevisions{}).Error; err != nil {
+			log.Errorf("db delete template revisions %v", err)
+			return translateError(err)
+		}
+		return nil
+	})
 }
 
 func FindTemplate(id int64) (*ContainerTemplates, error) {
@@ -197,3 +306,82 @@ func FindTemplate(id int64) (*ContainerTemplates, error) {
 
 	return &data, nil
 }
+
+func FindTemplateByName(name string) (*ContainerTemplates, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data ContainerTemplates
+	if err := db.First(&data, "name = ?", name).Error; err != nil {
+		log.Infof("FindTemplateByName name=%v err=%v", name, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
+
+// 查询所有模板 ids为空时返回全部
+func QueryTemplates(ids []int64) ([]ContainerTemplates, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []ContainerTemplates
+	if len(ids) > 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	if err := db.Order("id").Find(&data).Error; err != nil {
+		log.Errorf("db query templates %v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
+
+func createTemplateRevision(tx *gorm.DB, t *ContainerTemplates) error {
+	r := ContainerTemplateRevisions{
+		TemplateId: t.Id,
+		Version:    t.Version,
+		Name:       t.Name,
+		NodeId:     t.NodeId,
+		ConfigJSON: t.ConfigJSON,
+	}
+	if err := tx.Create(&r).Error; err != nil {
+		log.Errorf("db create template revision %v", err)
+		return translateError(err)
+	}
+	return nil
+}
+
+func ListTemplateRevisions(templateID int64) ([]ContainerTemplateRevisions, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []ContainerTemplateRevisions
+	if err := db.Where("template_id = ?", templateID).Order("version DESC").Find(&data).Error; err != nil {
+		log.Errorf("db list template revisions %v", err)
+		return nil, translateError(err)
+	}
+
+	return data, nil
+}
+
+func FindTemplateRevision(templateID, version int64) (*ContainerTemplateRevisions, error) {
+	db, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var data ContainerTemplateRevisions
+	if err := db.First(&data, "template_id = ? AND version = ?", templateID, version).Error; err != nil {
+		log.Infof("FindTemplateRevision template=%v version=%v err=%v", templateID, version, err)
+		return nil, translateError(err)
+	}
+
+	return &data, nil
+}
@@ -54,7 +54,8 @@ type UserSession struct {
 
 type UserRoleInfo struct {
 	UserInfo
-	PermsJSON string
+	PermsJSON           string
+	OwnedContainersOnly bool
 }
 
 func (UserRoleInfo) TableName() string {
@@ -277,7 +278,7 @@ func QueryUserRole(userID interface{}) (*UserRoleInfo, error) {
 
 	var r UserRoleInfo
 	db.Model(&UserRoleInfo{}).Joins("LEFT JOIN user_roles ON user_infos.role_id = user_roles.id").
-		Select("user_infos.*, user_roles.perms_json").First(&r, "user_infos.id = ?", userID)
+		Select("user_infos.*, user_roles.perms_json, user_roles.owned_containers_only").First(&r, "user_infos.id = ?", userID)
 
 	return &r, nil
 }
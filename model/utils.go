@@ -63,3 +63,8 @@ func MysqlEscape(s string) string {
 
 	return sb.String()
 }
+
+// LIKE模式中转义通配符, 用于匹配字面字符串
+func likeEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,21 @@
+package model
+
+import "testing"
+
+func TestLikeEscape(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"default", "default"},
+		{"a_b", `a\_b`},
+		{"100%", `100\%`},
+		{`a\b`, `a\\b`},
+		{`%_\`, `\%\_\\`},
+	}
+	for _, tt := range tests {
+		if got := likeEscape(tt.in); got != tt.want {
+			t.Errorf("likeEscape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
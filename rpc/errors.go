@@ -38,6 +38,9 @@ var (
 
 	ErrContainerBasicConfigNoPerm    = status.Error(codes.PermissionDenied, "无权限设置容器常规配置")
 	ErrContainerSecurityConfigNoPerm = status.Error(codes.PermissionDenied, "无权限设置容器安全策略")
+	ErrContainerExecNoPerm           = status.Error(codes.PermissionDenied, "容器已禁止命令行控制，无权限执行命令")
+	ErrContainerDeviceNoPerm         = status.Error(codes.PermissionDenied, "无权限设置容器设备直通")
+	ErrContainerNotOwned             = status.Error(codes.PermissionDenied, "无权限访问该容器")
 
 	ErrContainerProcProtection  = rpcError(pb.Errno_CProcProtectionFailed, "配置进程保护失败")
 	ErrContainerNprocProtection = rpcError(pb.Errno_CNprocProtectionFailed, "配置网络进程保护失败")
@@ -40,10 +40,23 @@ type ContainerClient interface {
 	ResumeBackup(ctx context.Context, in *ResumeBackupRequest, opts ...grpc.CallOption) (*ResumeBackupReply, error)
 	RemoveBackup(ctx context.Context, in *RemoveBackupRequest, opts ...grpc.CallOption) (*RemoveBackupReply, error)
 	ListBackup(ctx context.Context, in *ListBackupRequest, opts ...grpc.CallOption) (*ListBackupReply, error)
+	CancelBackupJob(ctx context.Context, in *CancelBackupJobRequest, opts ...grpc.CallOption) (*CancelBackupJobReply, error)
+	// 容器定时备份 由主控制器按cron表达式执行, 并按保留策略清理旧备份
+	CreateBackupSchedule(ctx context.Context, in *CreateBackupScheduleRequest, opts ...grpc.CallOption) (*CreateBackupScheduleReply, error)
+	UpdateBackupSchedule(ctx context.Context, in *UpdateBackupScheduleRequest, opts ...grpc.CallOption) (*UpdateBackupScheduleReply, error)
+	RemoveBackupSchedule(ctx context.Context, in *RemoveBackupScheduleRequest, opts ...grpc.CallOption) (*RemoveBackupScheduleReply, error)
+	ListBackupSchedule(ctx context.Context, in *ListBackupScheduleRequest, opts ...grpc.CallOption) (*ListBackupScheduleReply, error)
 	// 容器备份内部接口
 	AddBackupJob(ctx context.Context, in *AddBackupJobRequest, opts ...grpc.CallOption) (*AddBackupJobReply, error)
 	GetBackupJob(ctx context.Context, in *GetBackupJobRequest, opts ...grpc.CallOption) (*GetBackupJobReply, error)
+	ListBackupJob(ctx context.Context, in *ListBackupJobRequest, opts ...grpc.CallOption) (*ListBackupJobReply, error)
 	DelBackupJob(ctx context.Context, in *DelBackupJobRequest, opts ...grpc.CallOption) (*DelBackupJobReply, error)
+	WatchBackupJob(ctx context.Context, in *WatchBackupJobRequest, opts ...grpc.CallOption) (Container_WatchBackupJobClient, error)
+	PushBackup(ctx context.Context, in *PushBackupRequest, opts ...grpc.CallOption) (*PushBackupReply, error)
+	// 迁移容器到其他节点
+	Migrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*MigrateReply, error)
+	// 容器迁移内部接口 提交容器为镜像并推送至registry
+	CommitImage(ctx context.Context, in *CommitImageRequest, opts ...grpc.CallOption) (*CommitImageReply, error)
 	// 查询容器模板列表
 	ListTemplate(ctx context.Context, in *ListTemplateRequest, opts ...grpc.CallOption) (*ListTemplateReply, error)
 	// 创建容器模板
@@ -54,8 +67,44 @@ type ContainerClient interface {
 	InspectTemplate(ctx context.Context, in *InspectTemplateRequest, opts ...grpc.CallOption) (*InspectTemplateReply, error)
 	// 删除容器模板
 	RemoveTemplate(ctx context.Context, in *RemoveTemplateRequest, opts ...grpc.CallOption) (*RemoveTemplateReply, error)
+	// 使用模板创建容器
+	CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*CreateFromTemplateReply, error)
+	// 模板历史版本
+	ListTemplateRevision(ctx context.Context, in *ListTemplateRevisionRequest, opts ...grpc.CallOption) (*ListTemplateRevisionReply, error)
+	// 比较模板两个版本的差异
+	DiffTemplate(ctx context.Context, in *DiffTemplateRequest, opts ...grpc.CallOption) (*DiffTemplateReply, error)
+	// 回滚模板至历史版本 回滚结果作为新版本保存
+	RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*RollbackTemplateReply, error)
+	// 导出模板文件 用于在控制器之间迁移模板
+	ExportTemplate(ctx context.Context, in *ExportTemplateRequest, opts ...grpc.CallOption) (*ExportTemplateReply, error)
+	// 导入模板文件
+	ImportTemplate(ctx context.Context, in *ImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplateReply, error)
+	// 检查模板能否在节点上创建容器
+	ValidateTemplate(ctx context.Context, in *ValidateTemplateRequest, opts ...grpc.CallOption) (*ValidateTemplateReply, error)
 	// 监控历史数据查询
 	MonitorHistory(ctx context.Context, in *MonitorHistoryRequest, opts ...grpc.CallOption) (*MonitorHistoryReply, error)
+	// 容器终端 首个请求需指定node_id/container_id/cmd 后续请求传输输入数据或终端大小
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Container_ExecClient, error)
+	// 容器标准输出/错误日志
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Container_LogsClient, error)
+	// 容器事件内部接口 获取agent缓存的docker容器事件
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsReply, error)
+	// 容器文件系统相对镜像的变化 标记被修改的防篡改保护文件
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffReply, error)
+	// 设置容器共享用户 被共享的用户可以查看和管理该容器
+	Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareReply, error)
+	// 导出容器 首个回复为容器配置 后续为docker export的tar数据
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Container_ExportClient, error)
+	// 导入容器 首个请求为导入信息 第二个请求为签名数据 后续为tar数据
+	// 导入的镜像需经过验签/审批, 审批通过后在指定节点创建容器
+	Import(ctx context.Context, opts ...grpc.CallOption) (Container_ImportClient, error)
+	// 下载备份文件 包含备份镜像/容器配置/挂载数据及签名的清单, 用于离线保存
+	DownloadBackup(ctx context.Context, in *DownloadBackupRequest, opts ...grpc.CallOption) (Container_DownloadBackupClient, error)
+	// 上传备份文件 首个请求指定目标节点, 验证清单签名后加载至节点, 容器不存在时创建容器记录
+	UploadBackup(ctx context.Context, opts ...grpc.CallOption) (Container_UploadBackupClient, error)
+	// 备份文件内部接口 按文件传输备份镜像及挂载数据归档
+	SaveBackup(ctx context.Context, in *SaveBackupRequest, opts ...grpc.CallOption) (Container_SaveBackupClient, error)
+	LoadBackup(ctx context.Context, opts ...grpc.CallOption) (Container_LoadBackupClient, error)
 }
 
 type containerClient struct {
@@ -183,6 +232,51 @@ func (c *containerClient) ListBackup(ctx context.Context, in *ListBackupRequest,
 	return out, nil
 }
 
+func (c *containerClient) CancelBackupJob(ctx context.Context, in *CancelBackupJobRequest, opts ...grpc.CallOption) (*CancelBackupJobReply, error) {
+	out := new(CancelBackupJobReply)
+	err := c.cc.Invoke(ctx, "/container.Container/CancelBackupJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) CreateBackupSchedule(ctx context.Context, in *CreateBackupScheduleRequest, opts ...grpc.CallOption) (*CreateBackupScheduleReply, error) {
+	out := new(CreateBackupScheduleReply)
+	err := c.cc.Invoke(ctx, "/container.Container/CreateBackupSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) UpdateBackupSchedule(ctx context.Context, in *UpdateBackupScheduleRequest, opts ...grpc.CallOption) (*UpdateBackupScheduleReply, error) {
+	out := new(UpdateBackupScheduleReply)
+	err := c.cc.Invoke(ctx, "/container.Container/UpdateBackupSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) RemoveBackupSchedule(ctx context.Context, in *RemoveBackupScheduleRequest, opts ...grpc.CallOption) (*RemoveBackupScheduleReply, error) {
+	out := new(RemoveBackupScheduleReply)
+	err := c.cc.Invoke(ctx, "/container.Container/RemoveBackupSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) ListBackupSchedule(ctx context.Context, in *ListBackupScheduleRequest, opts ...grpc.CallOption) (*ListBackupScheduleReply, error) {
+	out := new(ListBackupScheduleReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ListBackupSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *containerClient) AddBackupJob(ctx context.Context, in *AddBackupJobRequest, opts ...grpc.CallOption) (*AddBackupJobReply, error) {
 	out := new(AddBackupJobReply)
 	err := c.cc.Invoke(ctx, "/container.Container/AddBackupJob", in, out, opts...)
@@ -201,6 +295,15 @@ func (c *containerClient) GetBackupJob(ctx context.Context, in *GetBackupJobRequ
 	return out, nil
 }
 
+func (c *containerClient) ListBackupJob(ctx context.Context, in *ListBackupJobRequest, opts ...grpc.CallOption) (*ListBackupJobReply, error) {
+	out := new(ListBackupJobReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ListBackupJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *containerClient) DelBackupJob(ctx context.Context, in *DelBackupJobRequest, opts ...grpc.CallOption) (*DelBackupJobReply, error) {
 	out := new(DelBackupJobReply)
 	err := c.cc.Invoke(ctx, "/container.Container/DelBackupJob", in, out, opts...)
@@ -210,6 +313,65 @@ func (c *containerClient) DelBackupJob(ctx context.Context, in *DelBackupJobRequ
 	return out, nil
 }
 
+func (c *containerClient) WatchBackupJob(ctx context.Context, in *WatchBackupJobRequest, opts ...grpc.CallOption) (Container_WatchBackupJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[0], "/container.Container/WatchBackupJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerWatchBackupJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Container_WatchBackupJobClient interface {
+	Recv() (*GetBackupJobReply, error)
+	grpc.ClientStream
+}
+
+type containerWatchBackupJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerWatchBackupJobClient) Recv() (*GetBackupJobReply, error) {
+	m := new(GetBackupJobReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) PushBackup(ctx context.Context, in *PushBackupRequest, opts ...grpc.CallOption) (*PushBackupReply, error) {
+	out := new(PushBackupReply)
+	err := c.cc.Invoke(ctx, "/container.Container/PushBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) Migrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*MigrateReply, error) {
+	out := new(MigrateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *containerClient) CommitImage(ctx context.Context, in *CommitImageRequest, opts ...grpc.CallOption) (*CommitImageReply, error) {
+	out := new(CommitImageReply)
+	err := c.cc.Invoke(ctx, "/container.Container/CommitImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *containerClient) ListTemplate(ctx context.Context, in *ListTemplateRequest, opts ...grpc.CallOption) (*ListTemplateReply, error) {
 	out := new(ListTemplateReply)
 	err := c.cc.Invoke(ctx, "/container.Container/ListTemplate", in, out, opts...)
@@ -255,539 +417,1574 @@ func (c *containerClient) RemoveTemplate(ctx context.Context, in *RemoveTemplate
 	return out, nil
 }
 
-func (c *containerClient) MonitorHistory(ctx context.Context, in *MonitorHistoryRequest, opts ...grpc.CallOption) (*MonitorHistoryReply, error) {
-	out := new(MonitorHistoryReply)
-	err := c.cc.Invoke(ctx, "/container.Container/MonitorHistory", in, out, opts...)
+func (c *containerClient) CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*CreateFromTemplateReply, error) {
+	out := new(CreateFromTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/CreateFromTemplate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ContainerServer is the server API for Container service.
-// All implementations must embed UnimplementedContainerServer
-// for forward compatibility
-type ContainerServer interface {
-	// 查询容器列表
-	List(context.Context, *ListRequest) (*ListReply, error)
-	// 创建新容器
-	Create(context.Context, *CreateRequest) (*CreateReply, error)
-	// 查看容器配置
-	Inspect(context.Context, *InspectRequest) (*InspectReply, error)
-	// 开始运行容器
-	Start(context.Context, *StartRequest) (*StartReply, error)
-	// 停止运行容器
-	Stop(context.Context, *StopRequest) (*StopReply, error)
-	// 删除容器
-	Remove(context.Context, *RemoveRequest) (*RemoveReply, error)
-	// 重启容器
-	Restart(context.Context, *RestartRequest) (*RestartReply, error)
-	// 更新容器配置
-	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
-	// 容器备份客户端接口
-	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupReply, error)
-	UpdateBackup(context.Context, *UpdateBackupRequest) (*UpdateBackupReply, error)
-	ResumeBackup(context.Context, *ResumeBackupRequest) (*ResumeBackupReply, error)
-	RemoveBackup(context.Context, *RemoveBackupRequest) (*RemoveBackupReply, error)
-	ListBackup(context.Context, *ListBackupRequest) (*ListBackupReply, error)
-	// 容器备份内部接口
-	AddBackupJob(context.Context, *AddBackupJobRequest) (*AddBackupJobReply, error)
-	GetBackupJob(context.Context, *GetBackupJobRequest) (*GetBackupJobReply, error)
-	DelBackupJob(context.Context, *DelBackupJobRequest) (*DelBackupJobReply, error)
-	// 查询容器模板列表
-	ListTemplate(context.Context, *ListTemplateRequest) (*ListTemplateReply, error)
-	// 创建容器模板
-	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error)
-	// 更新容器模板
-	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error)
-	// 查询单个容器模板详情
-	InspectTemplate(context.Context, *InspectTemplateRequest) (*InspectTemplateReply, error)
-	// 删除容器模板
-	RemoveTemplate(context.Context, *RemoveTemplateRequest) (*RemoveTemplateReply, error)
-	// 监控历史数据查询
-	MonitorHistory(context.Context, *MonitorHistoryRequest) (*MonitorHistoryReply, error)
-	mustEmbedUnimplementedContainerServer()
+func (c *containerClient) ListTemplateRevision(ctx context.Context, in *ListTemplateRevisionRequest, opts ...grpc.CallOption) (*ListTemplateRevisionReply, error) {
+	out := new(ListTemplateRevisionReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ListTemplateRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedContainerServer must be embedded to have forward compatible implementations.
-type UnimplementedContainerServer struct {
+func (c *containerClient) DiffTemplate(ctx context.Context, in *DiffTemplateRequest, opts ...grpc.CallOption) (*DiffTemplateReply, error) {
+	out := new(DiffTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/DiffTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedContainerServer) List(context.Context, *ListRequest) (*ListReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (UnimplementedContainerServer) Create(context.Context, *CreateRequest) (*CreateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (UnimplementedContainerServer) Inspect(context.Context, *InspectRequest) (*InspectReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
-}
-func (UnimplementedContainerServer) Start(context.Context, *StartRequest) (*StartReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
-}
-func (UnimplementedContainerServer) Stop(context.Context, *StopRequest) (*StopReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
-}
-func (UnimplementedContainerServer) Remove(context.Context, *RemoveRequest) (*RemoveReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
-}
-func (UnimplementedContainerServer) Restart(context.Context, *RestartRequest) (*RestartReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
-}
-func (UnimplementedContainerServer) Update(context.Context, *UpdateRequest) (*UpdateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (c *containerClient) RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*RollbackTemplateReply, error) {
+	out := new(RollbackTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/RollbackTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+
+func (c *containerClient) ExportTemplate(ctx context.Context, in *ExportTemplateRequest, opts ...grpc.CallOption) (*ExportTemplateReply, error) {
+	out := new(ExportTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ExportTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) UpdateBackup(context.Context, *UpdateBackupRequest) (*UpdateBackupReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBackup not implemented")
+
+func (c *containerClient) ImportTemplate(ctx context.Context, in *ImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplateReply, error) {
+	out := new(ImportTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ImportTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) ResumeBackup(context.Context, *ResumeBackupRequest) (*ResumeBackupReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResumeBackup not implemented")
+
+func (c *containerClient) ValidateTemplate(ctx context.Context, in *ValidateTemplateRequest, opts ...grpc.CallOption) (*ValidateTemplateReply, error) {
+	out := new(ValidateTemplateReply)
+	err := c.cc.Invoke(ctx, "/container.Container/ValidateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) RemoveBackup(context.Context, *RemoveBackupRequest) (*RemoveBackupReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveBackup not implemented")
+
+func (c *containerClient) MonitorHistory(ctx context.Context, in *MonitorHistoryRequest, opts ...grpc.CallOption) (*MonitorHistoryReply, error) {
+	out := new(MonitorHistoryReply)
+	err := c.cc.Invoke(ctx, "/container.Container/MonitorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) ListBackup(context.Context, *ListBackupRequest) (*ListBackupReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBackup not implemented")
+
+func (c *containerClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Container_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[1], "/container.Container/Exec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerExecClient{stream}
+	return x, nil
 }
-func (UnimplementedContainerServer) AddBackupJob(context.Context, *AddBackupJobRequest) (*AddBackupJobReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBackupJob not implemented")
+
+type Container_ExecClient interface {
+	Send(*ExecRequest) error
+	Recv() (*ExecReply, error)
+	grpc.ClientStream
 }
-func (UnimplementedContainerServer) GetBackupJob(context.Context, *GetBackupJobRequest) (*GetBackupJobReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBackupJob not implemented")
+
+type containerExecClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedContainerServer) DelBackupJob(context.Context, *DelBackupJobRequest) (*DelBackupJobReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelBackupJob not implemented")
+
+func (x *containerExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedContainerServer) ListTemplate(context.Context, *ListTemplateRequest) (*ListTemplateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplate not implemented")
+
+func (x *containerExecClient) Recv() (*ExecReply, error) {
+	m := new(ExecReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedContainerServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+
+func (c *containerClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Container_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[2], "/container.Container/Logs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedContainerServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+
+type Container_LogsClient interface {
+	Recv() (*LogsReply, error)
+	grpc.ClientStream
 }
-func (UnimplementedContainerServer) InspectTemplate(context.Context, *InspectTemplateRequest) (*InspectTemplateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectTemplate not implemented")
+
+type containerLogsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedContainerServer) RemoveTemplate(context.Context, *RemoveTemplateRequest) (*RemoveTemplateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTemplate not implemented")
+
+func (x *containerLogsClient) Recv() (*LogsReply, error) {
+	m := new(LogsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedContainerServer) MonitorHistory(context.Context, *MonitorHistoryRequest) (*MonitorHistoryReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MonitorHistory not implemented")
+
+func (c *containerClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsReply, error) {
+	out := new(EventsReply)
+	err := c.cc.Invoke(ctx, "/container.Container/Events", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedContainerServer) mustEmbedUnimplementedContainerServer() {}
 
-// UnsafeContainerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ContainerServer will
-// result in compilation errors.
-type UnsafeContainerServer interface {
-	mustEmbedUnimplementedContainerServer()
+func (c *containerClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffReply, error) {
+	out := new(DiffReply)
+	err := c.cc.Invoke(ctx, "/container.Container/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterContainerServer(s grpc.ServiceRegistrar, srv ContainerServer) {
-	s.RegisterService(&Container_ServiceDesc, srv)
+func (c *containerClient) Share(ctx context.Context, in *ShareRequest, opts ...grpc.CallOption) (*ShareReply, error) {
+	out := new(ShareReply)
+	err := c.cc.Invoke(ctx, "/container.Container/Share", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Container_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
-	if err := dec(in); err != nil {
+func (c *containerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Container_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[3], "/container.Container/Export", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ContainerServer).List(ctx, in)
+	x := &containerExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Container_ExportClient interface {
+	Recv() (*ExportReply, error)
+	grpc.ClientStream
+}
+
+type containerExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerExportClient) Recv() (*ExportReply, error) {
+	m := new(ExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) Import(ctx context.Context, opts ...grpc.CallOption) (Container_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[4], "/container.Container/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerImportClient{stream}
+	return x, nil
+}
+
+type Container_ImportClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportReply, error)
+	grpc.ClientStream
+}
+
+type containerImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerImportClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *containerImportClient) CloseAndRecv() (*ImportReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) DownloadBackup(ctx context.Context, in *DownloadBackupRequest, opts ...grpc.CallOption) (Container_DownloadBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[5], "/container.Container/DownloadBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerDownloadBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Container_DownloadBackupClient interface {
+	Recv() (*DownloadBackupReply, error)
+	grpc.ClientStream
+}
+
+type containerDownloadBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerDownloadBackupClient) Recv() (*DownloadBackupReply, error) {
+	m := new(DownloadBackupReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) UploadBackup(ctx context.Context, opts ...grpc.CallOption) (Container_UploadBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[6], "/container.Container/UploadBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerUploadBackupClient{stream}
+	return x, nil
+}
+
+type Container_UploadBackupClient interface {
+	Send(*UploadBackupRequest) error
+	CloseAndRecv() (*UploadBackupReply, error)
+	grpc.ClientStream
+}
+
+type containerUploadBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerUploadBackupClient) Send(m *UploadBackupRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *containerUploadBackupClient) CloseAndRecv() (*UploadBackupReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadBackupReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) SaveBackup(ctx context.Context, in *SaveBackupRequest, opts ...grpc.CallOption) (Container_SaveBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[7], "/container.Container/SaveBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerSaveBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Container_SaveBackupClient interface {
+	Recv() (*BackupFileChunk, error)
+	grpc.ClientStream
+}
+
+type containerSaveBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerSaveBackupClient) Recv() (*BackupFileChunk, error) {
+	m := new(BackupFileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *containerClient) LoadBackup(ctx context.Context, opts ...grpc.CallOption) (Container_LoadBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Container_ServiceDesc.Streams[8], "/container.Container/LoadBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &containerLoadBackupClient{stream}
+	return x, nil
+}
+
+type Container_LoadBackupClient interface {
+	Send(*LoadBackupRequest) error
+	CloseAndRecv() (*LoadBackupReply, error)
+	grpc.ClientStream
+}
+
+type containerLoadBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *containerLoadBackupClient) Send(m *LoadBackupRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *containerLoadBackupClient) CloseAndRecv() (*LoadBackupReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(LoadBackupReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ContainerServer is the server API for Container service.
+// All implementations must embed UnimplementedContainerServer
+// for forward compatibility
+type ContainerServer interface {
+	// 查询容器列表
+	List(context.Context, *ListRequest) (*ListReply, error)
+	// 创建新容器
+	Create(context.Context, *CreateRequest) (*CreateReply, error)
+	// 查看容器配置
+	Inspect(context.Context, *InspectRequest) (*InspectReply, error)
+	// 开始运行容器
+	Start(context.Context, *StartRequest) (*StartReply, error)
+	// 停止运行容器
+	Stop(context.Context, *StopRequest) (*StopReply, error)
+	// 删除容器
+	Remove(context.Context, *RemoveRequest) (*RemoveReply, error)
+	// 重启容器
+	Restart(context.Context, *RestartRequest) (*RestartReply, error)
+	// 更新容器配置
+	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
+	// 容器备份客户端接口
+	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupReply, error)
+	UpdateBackup(context.Context, *UpdateBackupRequest) (*UpdateBackupReply, error)
+	ResumeBackup(context.Context, *ResumeBackupRequest) (*ResumeBackupReply, error)
+	RemoveBackup(context.Context, *RemoveBackupRequest) (*RemoveBackupReply, error)
+	ListBackup(context.Context, *ListBackupRequest) (*ListBackupReply, error)
+	CancelBackupJob(context.Context, *CancelBackupJobRequest) (*CancelBackupJobReply, error)
+	// 容器定时备份 由主控制器按cron表达式执行, 并按保留策略清理旧备份
+	CreateBackupSchedule(context.Context, *CreateBackupScheduleRequest) (*CreateBackupScheduleReply, error)
+	UpdateBackupSchedule(context.Context, *UpdateBackupScheduleRequest) (*UpdateBackupScheduleReply, error)
+	RemoveBackupSchedule(context.Context, *RemoveBackupScheduleRequest) (*RemoveBackupScheduleReply, error)
+	ListBackupSchedule(context.Context, *ListBackupScheduleRequest) (*ListBackupScheduleReply, error)
+	// 容器备份内部接口
+	AddBackupJob(context.Context, *AddBackupJobRequest) (*AddBackupJobReply, error)
+	GetBackupJob(context.Context, *GetBackupJobRequest) (*GetBackupJobReply, error)
+	ListBackupJob(context.Context, *ListBackupJobRequest) (*ListBackupJobReply, error)
+	DelBackupJob(context.Context, *DelBackupJobRequest) (*DelBackupJobReply, error)
+	WatchBackupJob(*WatchBackupJobRequest, Container_WatchBackupJobServer) error
+	PushBackup(context.Context, *PushBackupRequest) (*PushBackupReply, error)
+	// 迁移容器到其他节点
+	Migrate(context.Context, *MigrateRequest) (*MigrateReply, error)
+	// 容器迁移内部接口 提交容器为镜像并推送至registry
+	CommitImage(context.Context, *CommitImageRequest) (*CommitImageReply, error)
+	// 查询容器模板列表
+	ListTemplate(context.Context, *ListTemplateRequest) (*ListTemplateReply, error)
+	// 创建容器模板
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error)
+	// 更新容器模板
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error)
+	// 查询单个容器模板详情
+	InspectTemplate(context.Context, *InspectTemplateRequest) (*InspectTemplateReply, error)
+	// 删除容器模板
+	RemoveTemplate(context.Context, *RemoveTemplateRequest) (*RemoveTemplateReply, error)
+	// 使用模板创建容器
+	CreateFromTemplate(context.Context, *CreateFromTemplateRequest) (*CreateFromTemplateReply, error)
+	// 模板历史版本
+	ListTemplateRevision(context.Context, *ListTemplateRevisionRequest) (*ListTemplateRevisionReply, error)
+	// 比较模板两个版本的差异
+	DiffTemplate(context.Context, *DiffTemplateRequest) (*DiffTemplateReply, error)
+	// 回滚模板至历史版本 回滚结果作为新版本保存
+	RollbackTemplate(context.Context, *RollbackTemplateRequest) (*RollbackTemplateReply, error)
+	// 导出模板文件 用于在控制器之间迁移模板
+	ExportTemplate(context.Context, *ExportTemplateRequest) (*ExportTemplateReply, error)
+	// 导入模板文件
+	ImportTemplate(context.Context, *ImportTemplateRequest) (*ImportTemplateReply, error)
+	// 检查模板能否在节点上创建容器
+	ValidateTemplate(context.Context, *ValidateTemplateRequest) (*ValidateTemplateReply, error)
+	// 监控历史数据查询
+	MonitorHistory(context.Context, *MonitorHistoryRequest) (*MonitorHistoryReply, error)
+	// 容器终端 首个请求需指定node_id/container_id/cmd 后续请求传输输入数据或终端大小
+	Exec(Container_ExecServer) error
+	// 容器标准输出/错误日志
+	Logs(*LogsRequest, Container_LogsServer) error
+	// 容器事件内部接口 获取agent缓存的docker容器事件
+	Events(context.Context, *EventsRequest) (*EventsReply, error)
+	// 容器文件系统相对镜像的变化 标记被修改的防篡改保护文件
+	Diff(context.Context, *DiffRequest) (*DiffReply, error)
+	// 设置容器共享用户 被共享的用户可以查看和管理该容器
+	Share(context.Context, *ShareRequest) (*ShareReply, error)
+	// 导出容器 首个回复为容器配置 后续为docker export的tar数据
+	Export(*ExportRequest, Container_ExportServer) error
+	// 导入容器 首个请求为导入信息 第二个请求为签名数据 后续为tar数据
+	// 导入的镜像需经过验签/审批, 审批通过后在指定节点创建容器
+	Import(Container_ImportServer) error
+	// 下载备份文件 包含备份镜像/容器配置/挂载数据及签名的清单, 用于离线保存
+	DownloadBackup(*DownloadBackupRequest, Container_DownloadBackupServer) error
+	// 上传备份文件 首个请求指定目标节点, 验证清单签名后加载至节点, 容器不存在时创建容器记录
+	UploadBackup(Container_UploadBackupServer) error
+	// 备份文件内部接口 按文件传输备份镜像及挂载数据归档
+	SaveBackup(*SaveBackupRequest, Container_SaveBackupServer) error
+	LoadBackup(Container_LoadBackupServer) error
+	mustEmbedUnimplementedContainerServer()
+}
+
+// UnimplementedContainerServer must be embedded to have forward compatible implementations.
+type UnimplementedContainerServer struct {
+}
+
+func (UnimplementedContainerServer) List(context.Context, *ListRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedContainerServer) Create(context.Context, *CreateRequest) (*CreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedContainerServer) Inspect(context.Context, *InspectRequest) (*InspectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
+}
+func (UnimplementedContainerServer) Start(context.Context, *StartRequest) (*StartReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedContainerServer) Stop(context.Context, *StopRequest) (*StopReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedContainerServer) Remove(context.Context, *RemoveRequest) (*RemoveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (UnimplementedContainerServer) Restart(context.Context, *RestartRequest) (*RestartReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedContainerServer) Update(context.Context, *UpdateRequest) (*UpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedContainerServer) CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (UnimplementedContainerServer) UpdateBackup(context.Context, *UpdateBackupRequest) (*UpdateBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBackup not implemented")
+}
+func (UnimplementedContainerServer) ResumeBackup(context.Context, *ResumeBackupRequest) (*ResumeBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBackup not implemented")
+}
+func (UnimplementedContainerServer) RemoveBackup(context.Context, *RemoveBackupRequest) (*RemoveBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBackup not implemented")
+}
+func (UnimplementedContainerServer) ListBackup(context.Context, *ListBackupRequest) (*ListBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackup not implemented")
+}
+func (UnimplementedContainerServer) CancelBackupJob(context.Context, *CancelBackupJobRequest) (*CancelBackupJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBackupJob not implemented")
+}
+func (UnimplementedContainerServer) CreateBackupSchedule(context.Context, *CreateBackupScheduleRequest) (*CreateBackupScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackupSchedule not implemented")
+}
+func (UnimplementedContainerServer) UpdateBackupSchedule(context.Context, *UpdateBackupScheduleRequest) (*UpdateBackupScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBackupSchedule not implemented")
+}
+func (UnimplementedContainerServer) RemoveBackupSchedule(context.Context, *RemoveBackupScheduleRequest) (*RemoveBackupScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBackupSchedule not implemented")
+}
+func (UnimplementedContainerServer) ListBackupSchedule(context.Context, *ListBackupScheduleRequest) (*ListBackupScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackupSchedule not implemented")
+}
+func (UnimplementedContainerServer) AddBackupJob(context.Context, *AddBackupJobRequest) (*AddBackupJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBackupJob not implemented")
+}
+func (UnimplementedContainerServer) GetBackupJob(context.Context, *GetBackupJobRequest) (*GetBackupJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackupJob not implemented")
+}
+func (UnimplementedContainerServer) ListBackupJob(context.Context, *ListBackupJobRequest) (*ListBackupJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackupJob not implemented")
+}
+func (UnimplementedContainerServer) DelBackupJob(context.Context, *DelBackupJobRequest) (*DelBackupJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelBackupJob not implemented")
+}
+func (UnimplementedContainerServer) WatchBackupJob(*WatchBackupJobRequest, Container_WatchBackupJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBackupJob not implemented")
+}
+func (UnimplementedContainerServer) PushBackup(context.Context, *PushBackupRequest) (*PushBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushBackup not implemented")
+}
+func (UnimplementedContainerServer) Migrate(context.Context, *MigrateRequest) (*MigrateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedContainerServer) CommitImage(context.Context, *CommitImageRequest) (*CommitImageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitImage not implemented")
+}
+func (UnimplementedContainerServer) ListTemplate(context.Context, *ListTemplateRequest) (*ListTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplate not implemented")
+}
+func (UnimplementedContainerServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedContainerServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedContainerServer) InspectTemplate(context.Context, *InspectTemplateRequest) (*InspectTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectTemplate not implemented")
+}
+func (UnimplementedContainerServer) RemoveTemplate(context.Context, *RemoveTemplateRequest) (*RemoveTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTemplate not implemented")
+}
+func (UnimplementedContainerServer) CreateFromTemplate(context.Context, *CreateFromTemplateRequest) (*CreateFromTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFromTemplate not implemented")
+}
+func (UnimplementedContainerServer) ListTemplateRevision(context.Context, *ListTemplateRevisionRequest) (*ListTemplateRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevision not implemented")
+}
+func (UnimplementedContainerServer) DiffTemplate(context.Context, *DiffTemplateRequest) (*DiffTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffTemplate not implemented")
+}
+func (UnimplementedContainerServer) RollbackTemplate(context.Context, *RollbackTemplateRequest) (*RollbackTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTemplate not implemented")
+}
+func (UnimplementedContainerServer) ExportTemplate(context.Context, *ExportTemplateRequest) (*ExportTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTemplate not implemented")
+}
+func (UnimplementedContainerServer) ImportTemplate(context.Context, *ImportTemplateRequest) (*ImportTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplate not implemented")
+}
+func (UnimplementedContainerServer) ValidateTemplate(context.Context, *ValidateTemplateRequest) (*ValidateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTemplate not implemented")
+}
+func (UnimplementedContainerServer) MonitorHistory(context.Context, *MonitorHistoryRequest) (*MonitorHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorHistory not implemented")
+}
+func (UnimplementedContainerServer) Exec(Container_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedContainerServer) Logs(*LogsRequest, Container_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedContainerServer) Events(context.Context, *EventsRequest) (*EventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedContainerServer) Diff(context.Context, *DiffRequest) (*DiffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (UnimplementedContainerServer) Share(context.Context, *ShareRequest) (*ShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+func (UnimplementedContainerServer) Export(*ExportRequest, Container_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedContainerServer) Import(Container_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedContainerServer) DownloadBackup(*DownloadBackupRequest, Container_DownloadBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBackup not implemented")
+}
+func (UnimplementedContainerServer) UploadBackup(Container_UploadBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBackup not implemented")
+}
+func (UnimplementedContainerServer) SaveBackup(*SaveBackupRequest, Container_SaveBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method SaveBackup not implemented")
+}
+func (UnimplementedContainerServer) LoadBackup(Container_LoadBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method LoadBackup not implemented")
+}
+func (UnimplementedContainerServer) mustEmbedUnimplementedContainerServer() {}
+
+// UnsafeContainerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContainerServer will
+// result in compilation errors.
+type UnsafeContainerServer interface {
+	mustEmbedUnimplementedContainerServer()
+}
+
+func RegisterContainerServer(s grpc.ServiceRegistrar, srv ContainerServer) {
+	s.RegisterService(&Container_ServiceDesc, srv)
+}
+
+func _Container_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Inspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Inspect(ctx, req.(*InspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Start(ctx, req.(*StartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Stop(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Restart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/List",
+		FullMethod: "/container.Container/Restart",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).List(ctx, req.(*ListRequest))
+		return srv.(ContainerServer).Restart(ctx, req.(*RestartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
+func _Container_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Create(ctx, in)
+		return srv.(ContainerServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Create",
+		FullMethod: "/container.Container/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Create(ctx, req.(*CreateRequest))
+		return srv.(ContainerServer).Update(ctx, req.(*UpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRequest)
+func _Container_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Inspect(ctx, in)
+		return srv.(ContainerServer).CreateBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Inspect",
+		FullMethod: "/container.Container/CreateBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Inspect(ctx, req.(*InspectRequest))
+		return srv.(ContainerServer).CreateBackup(ctx, req.(*CreateBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartRequest)
+func _Container_UpdateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Start(ctx, in)
+		return srv.(ContainerServer).UpdateBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Start",
+		FullMethod: "/container.Container/UpdateBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Start(ctx, req.(*StartRequest))
+		return srv.(ContainerServer).UpdateBackup(ctx, req.(*UpdateBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
+func _Container_ResumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Stop(ctx, in)
+		return srv.(ContainerServer).ResumeBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Stop",
+		FullMethod: "/container.Container/ResumeBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Stop(ctx, req.(*StopRequest))
+		return srv.(ContainerServer).ResumeBackup(ctx, req.(*ResumeBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_RemoveBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).RemoveBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/RemoveBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).RemoveBackup(ctx, req.(*RemoveBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_ListBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).ListBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/ListBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).ListBackup(ctx, req.(*ListBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_CancelBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBackupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).CancelBackupJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/CancelBackupJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).CancelBackupJob(ctx, req.(*CancelBackupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_CreateBackupSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).CreateBackupSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/CreateBackupSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).CreateBackupSchedule(ctx, req.(*CreateBackupScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_UpdateBackupSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBackupScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).UpdateBackupSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/UpdateBackupSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).UpdateBackupSchedule(ctx, req.(*UpdateBackupScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_RemoveBackupSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBackupScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).RemoveBackupSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/RemoveBackupSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).RemoveBackupSchedule(ctx, req.(*RemoveBackupScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_ListBackupSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).ListBackupSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/ListBackupSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).ListBackupSchedule(ctx, req.(*ListBackupScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_AddBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBackupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).AddBackupJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/AddBackupJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).AddBackupJob(ctx, req.(*AddBackupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_GetBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).GetBackupJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/GetBackupJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).GetBackupJob(ctx, req.(*GetBackupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_ListBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).ListBackupJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/ListBackupJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).ListBackupJob(ctx, req.(*ListBackupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_DelBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelBackupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).DelBackupJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/DelBackupJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).DelBackupJob(ctx, req.(*DelBackupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_WatchBackupJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBackupJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServer).WatchBackupJob(m, &containerWatchBackupJobServer{stream})
+}
+
+type Container_WatchBackupJobServer interface {
+	Send(*GetBackupJobReply) error
+	grpc.ServerStream
+}
+
+type containerWatchBackupJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerWatchBackupJobServer) Send(m *GetBackupJobReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Container_PushBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).PushBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/PushBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).PushBackup(ctx, req.(*PushBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Container_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContainerServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/container.Container/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContainerServer).Migrate(ctx, req.(*MigrateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
+func _Container_CommitImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Remove(ctx, in)
+		return srv.(ContainerServer).CommitImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Remove",
+		FullMethod: "/container.Container/CommitImage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Remove(ctx, req.(*RemoveRequest))
+		return srv.(ContainerServer).CommitImage(ctx, req.(*CommitImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartRequest)
+func _Container_ListTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Restart(ctx, in)
+		return srv.(ContainerServer).ListTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Restart",
+		FullMethod: "/container.Container/ListTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Restart(ctx, req.(*RestartRequest))
+		return srv.(ContainerServer).ListTemplate(ctx, req.(*ListTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
+func _Container_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).Update(ctx, in)
+		return srv.(ContainerServer).CreateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/Update",
+		FullMethod: "/container.Container/CreateTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).Update(ctx, req.(*UpdateRequest))
+		return srv.(ContainerServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBackupRequest)
+func _Container_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).CreateBackup(ctx, in)
+		return srv.(ContainerServer).UpdateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/CreateBackup",
+		FullMethod: "/container.Container/UpdateTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).CreateBackup(ctx, req.(*CreateBackupRequest))
+		return srv.(ContainerServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_UpdateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBackupRequest)
+func _Container_InspectTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).UpdateBackup(ctx, in)
+		return srv.(ContainerServer).InspectTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/UpdateBackup",
+		FullMethod: "/container.Container/InspectTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).UpdateBackup(ctx, req.(*UpdateBackupRequest))
+		return srv.(ContainerServer).InspectTemplate(ctx, req.(*InspectTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_ResumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResumeBackupRequest)
+func _Container_RemoveTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).ResumeBackup(ctx, in)
+		return srv.(ContainerServer).RemoveTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/ResumeBackup",
+		FullMethod: "/container.Container/RemoveTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).ResumeBackup(ctx, req.(*ResumeBackupRequest))
+		return srv.(ContainerServer).RemoveTemplate(ctx, req.(*RemoveTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_RemoveBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveBackupRequest)
+func _Container_CreateFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFromTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).RemoveBackup(ctx, in)
+		return srv.(ContainerServer).CreateFromTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/RemoveBackup",
+		FullMethod: "/container.Container/CreateFromTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).RemoveBackup(ctx, req.(*RemoveBackupRequest))
+		return srv.(ContainerServer).CreateFromTemplate(ctx, req.(*CreateFromTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_ListBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBackupRequest)
+func _Container_ListTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).ListBackup(ctx, in)
+		return srv.(ContainerServer).ListTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/ListBackup",
+		FullMethod: "/container.Container/ListTemplateRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).ListBackup(ctx, req.(*ListBackupRequest))
+		return srv.(ContainerServer).ListTemplateRevision(ctx, req.(*ListTemplateRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_AddBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddBackupJobRequest)
+func _Container_DiffTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).AddBackupJob(ctx, in)
+		return srv.(ContainerServer).DiffTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/AddBackupJob",
+		FullMethod: "/container.Container/DiffTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).AddBackupJob(ctx, req.(*AddBackupJobRequest))
+		return srv.(ContainerServer).DiffTemplate(ctx, req.(*DiffTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_GetBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBackupJobRequest)
+func _Container_RollbackTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).GetBackupJob(ctx, in)
+		return srv.(ContainerServer).RollbackTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/GetBackupJob",
+		FullMethod: "/container.Container/RollbackTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).GetBackupJob(ctx, req.(*GetBackupJobRequest))
+		return srv.(ContainerServer).RollbackTemplate(ctx, req.(*RollbackTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_DelBackupJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelBackupJobRequest)
+func _Container_ExportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).DelBackupJob(ctx, in)
+		return srv.(ContainerServer).ExportTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/DelBackupJob",
+		FullMethod: "/container.Container/ExportTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).DelBackupJob(ctx, req.(*DelBackupJobRequest))
+		return srv.(ContainerServer).ExportTemplate(ctx, req.(*ExportTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_ListTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateRequest)
+func _Container_ImportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).ListTemplate(ctx, in)
+		return srv.(ContainerServer).ImportTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/ListTemplate",
+		FullMethod: "/container.Container/ImportTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).ListTemplate(ctx, req.(*ListTemplateRequest))
+		return srv.(ContainerServer).ImportTemplate(ctx, req.(*ImportTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateRequest)
+func _Container_ValidateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).CreateTemplate(ctx, in)
+		return srv.(ContainerServer).ValidateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/CreateTemplate",
+		FullMethod: "/container.Container/ValidateTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+		return srv.(ContainerServer).ValidateTemplate(ctx, req.(*ValidateTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateRequest)
+func _Container_MonitorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).UpdateTemplate(ctx, in)
+		return srv.(ContainerServer).MonitorHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/UpdateTemplate",
+		FullMethod: "/container.Container/MonitorHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+		return srv.(ContainerServer).MonitorHistory(ctx, req.(*MonitorHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_InspectTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectTemplateRequest)
+func _Container_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContainerServer).Exec(&containerExecServer{stream})
+}
+
+type Container_ExecServer interface {
+	Send(*ExecReply) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type containerExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerExecServer) Send(m *ExecReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *containerExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Container_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServer).Logs(m, &containerLogsServer{stream})
+}
+
+type Container_LogsServer interface {
+	Send(*LogsReply) error
+	grpc.ServerStream
+}
+
+type containerLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerLogsServer) Send(m *LogsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Container_Events_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).InspectTemplate(ctx, in)
+		return srv.(ContainerServer).Events(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/InspectTemplate",
+		FullMethod: "/container.Container/Events",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).InspectTemplate(ctx, req.(*InspectTemplateRequest))
+		return srv.(ContainerServer).Events(ctx, req.(*EventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_RemoveTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTemplateRequest)
+func _Container_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).RemoveTemplate(ctx, in)
+		return srv.(ContainerServer).Diff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/RemoveTemplate",
+		FullMethod: "/container.Container/Diff",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).RemoveTemplate(ctx, req.(*RemoveTemplateRequest))
+		return srv.(ContainerServer).Diff(ctx, req.(*DiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Container_MonitorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MonitorHistoryRequest)
+func _Container_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContainerServer).MonitorHistory(ctx, in)
+		return srv.(ContainerServer).Share(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/container.Container/MonitorHistory",
+		FullMethod: "/container.Container/Share",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContainerServer).MonitorHistory(ctx, req.(*MonitorHistoryRequest))
+		return srv.(ContainerServer).Share(ctx, req.(*ShareRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Container_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServer).Export(m, &containerExportServer{stream})
+}
+
+type Container_ExportServer interface {
+	Send(*ExportReply) error
+	grpc.ServerStream
+}
+
+type containerExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerExportServer) Send(m *ExportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Container_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContainerServer).Import(&containerImportServer{stream})
+}
+
+type Container_ImportServer interface {
+	SendAndClose(*ImportReply) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type containerImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerImportServer) SendAndClose(m *ImportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *containerImportServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Container_DownloadBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServer).DownloadBackup(m, &containerDownloadBackupServer{stream})
+}
+
+type Container_DownloadBackupServer interface {
+	Send(*DownloadBackupReply) error
+	grpc.ServerStream
+}
+
+type containerDownloadBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerDownloadBackupServer) Send(m *DownloadBackupReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Container_UploadBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContainerServer).UploadBackup(&containerUploadBackupServer{stream})
+}
+
+type Container_UploadBackupServer interface {
+	SendAndClose(*UploadBackupReply) error
+	Recv() (*UploadBackupRequest, error)
+	grpc.ServerStream
+}
+
+type containerUploadBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerUploadBackupServer) SendAndClose(m *UploadBackupReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *containerUploadBackupServer) Recv() (*UploadBackupRequest, error) {
+	m := new(UploadBackupRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Container_SaveBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SaveBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContainerServer).SaveBackup(m, &containerSaveBackupServer{stream})
+}
+
+type Container_SaveBackupServer interface {
+	Send(*BackupFileChunk) error
+	grpc.ServerStream
+}
+
+type containerSaveBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerSaveBackupServer) Send(m *BackupFileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Container_LoadBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContainerServer).LoadBackup(&containerLoadBackupServer{stream})
+}
+
+type Container_LoadBackupServer interface {
+	SendAndClose(*LoadBackupReply) error
+	Recv() (*LoadBackupRequest, error)
+	grpc.ServerStream
+}
+
+type containerLoadBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *containerLoadBackupServer) SendAndClose(m *LoadBackupReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *containerLoadBackupServer) Recv() (*LoadBackupRequest, error) {
+	m := new(LoadBackupRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Container_ServiceDesc is the grpc.ServiceDesc for Container service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -847,6 +2044,26 @@ var Container_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBackup",
 			Handler:    _Container_ListBackup_Handler,
 		},
+		{
+			MethodName: "CancelBackupJob",
+			Handler:    _Container_CancelBackupJob_Handler,
+		},
+		{
+			MethodName: "CreateBackupSchedule",
+			Handler:    _Container_CreateBackupSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateBackupSchedule",
+			Handler:    _Container_UpdateBackupSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveBackupSchedule",
+			Handler:    _Container_RemoveBackupSchedule_Handler,
+		},
+		{
+			MethodName: "ListBackupSchedule",
+			Handler:    _Container_ListBackupSchedule_Handler,
+		},
 		{
 			MethodName: "AddBackupJob",
 			Handler:    _Container_AddBackupJob_Handler,
@@ -855,10 +2072,26 @@ var Container_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBackupJob",
 			Handler:    _Container_GetBackupJob_Handler,
 		},
+		{
+			MethodName: "ListBackupJob",
+			Handler:    _Container_ListBackupJob_Handler,
+		},
 		{
 			MethodName: "DelBackupJob",
 			Handler:    _Container_DelBackupJob_Handler,
 		},
+		{
+			MethodName: "PushBackup",
+			Handler:    _Container_PushBackup_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Container_Migrate_Handler,
+		},
+		{
+			MethodName: "CommitImage",
+			Handler:    _Container_CommitImage_Handler,
+		},
 		{
 			MethodName: "ListTemplate",
 			Handler:    _Container_ListTemplate_Handler,
@@ -879,11 +2112,98 @@ var Container_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTemplate",
 			Handler:    _Container_RemoveTemplate_Handler,
 		},
+		{
+			MethodName: "CreateFromTemplate",
+			Handler:    _Container_CreateFromTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplateRevision",
+			Handler:    _Container_ListTemplateRevision_Handler,
+		},
+		{
+			MethodName: "DiffTemplate",
+			Handler:    _Container_DiffTemplate_Handler,
+		},
+		{
+			MethodName: "RollbackTemplate",
+			Handler:    _Container_RollbackTemplate_Handler,
+		},
+		{
+			MethodName: "ExportTemplate",
+			Handler:    _Container_ExportTemplate_Handler,
+		},
+		{
+			MethodName: "ImportTemplate",
+			Handler:    _Container_ImportTemplate_Handler,
+		},
+		{
+			MethodName: "ValidateTemplate",
+			Handler:    _Container_ValidateTemplate_Handler,
+		},
 		{
 			MethodName: "MonitorHistory",
 			Handler:    _Container_MonitorHistory_Handler,
 		},
+		{
+			MethodName: "Events",
+			Handler:    _Container_Events_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _Container_Diff_Handler,
+		},
+		{
+			MethodName: "Share",
+			Handler:    _Container_Share_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBackupJob",
+			Handler:       _Container_WatchBackupJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Container_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _Container_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Container_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Container_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadBackup",
+			Handler:       _Container_DownloadBackup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadBackup",
+			Handler:       _Container_UploadBackup_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SaveBackup",
+			Handler:       _Container_SaveBackup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LoadBackup",
+			Handler:       _Container_LoadBackup_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "container.proto",
 }
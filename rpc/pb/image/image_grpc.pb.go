@@ -22,8 +22,12 @@ type ImageClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
 	// 查询所有镜像列表
 	ListDB(ctx context.Context, in *ListDBRequest, opts ...grpc.CallOption) (*ListDBReply, error)
-	// 上传镜像
+	// 上传镜像 指定upload_id时从已提交的偏移量继续上传
 	Upload(ctx context.Context, opts ...grpc.CallOption) (Image_UploadClient, error)
+	// 创建上传会话
+	CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadReply, error)
+	// 查询上传会话已提交的偏移量
+	UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusReply, error)
 	// 更新已上传镜像
 	Update(ctx context.Context, opts ...grpc.CallOption) (Image_UpdateClient, error)
 	// 下载镜像文件
@@ -95,6 +99,24 @@ func (x *imageUploadClient) CloseAndRecv() (*UploadReply, error) {
 	return m, nil
 }
 
+func (c *imageClient) CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadReply, error) {
+	out := new(CreateUploadReply)
+	err := c.cc.Invoke(ctx, "/image.Image/CreateUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageClient) UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusReply, error) {
+	out := new(UploadStatusReply)
+	err := c.cc.Invoke(ctx, "/image.Image/UploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageClient) Update(ctx context.Context, opts ...grpc.CallOption) (Image_UpdateClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Image_ServiceDesc.Streams[1], "/image.Image/Update", opts...)
 	if err != nil {
@@ -196,8 +218,12 @@ type ImageServer interface {
 	List(context.Context, *ListRequest) (*ListReply, error)
 	// 查询所有镜像列表
 	ListDB(context.Context, *ListDBRequest) (*ListDBReply, error)
-	// 上传镜像
+	// 上传镜像 指定upload_id时从已提交的偏移量继续上传
 	Upload(Image_UploadServer) error
+	// 创建上传会话
+	CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadReply, error)
+	// 查询上传会话已提交的偏移量
+	UploadStatus(context.Context, *UploadStatusRequest) (*UploadStatusReply, error)
 	// 更新已上传镜像
 	Update(Image_UpdateServer) error
 	// 下载镜像文件
@@ -223,6 +249,12 @@ func (UnimplementedImageServer) ListDB(context.Context, *ListDBRequest) (*ListDB
 func (UnimplementedImageServer) Upload(Image_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (UnimplementedImageServer) CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpload not implemented")
+}
+func (UnimplementedImageServer) UploadStatus(context.Context, *UploadStatusRequest) (*UploadStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStatus not implemented")
+}
 func (UnimplementedImageServer) Update(Image_UpdateServer) error {
 	return status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -313,6 +345,42 @@ func (x *imageUploadServer) Recv() (*UploadRequest, error) {
 	return m, nil
 }
 
+func _Image_CreateUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).CreateUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/image.Image/CreateUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).CreateUpload(ctx, req.(*CreateUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Image_UploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServer).UploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/image.Image/UploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServer).UploadStatus(ctx, req.(*UploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Image_Update_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ImageServer).Update(&imageUpdateServer{stream})
 }
@@ -429,6 +497,14 @@ var Image_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDB",
 			Handler:    _Image_ListDB_Handler,
 		},
+		{
+			MethodName: "CreateUpload",
+			Handler:    _Image_CreateUpload_Handler,
+		},
+		{
+			MethodName: "UploadStatus",
+			Handler:    _Image_UploadStatus_Handler,
+		},
 		{
 			MethodName: "Approve",
 			Handler:    _Image_Approve_Handler,
@@ -83,33 +83,46 @@ func (EVENT_MODULE) EnumDescriptor() ([]byte, []int) {
 type EVENT_TYPE int32
 
 const (
-	EVENT_TYPE_TYPE_NONE              EVENT_TYPE = 0
-	EVENT_TYPE_CREATE_NODE            EVENT_TYPE = 101
-	EVENT_TYPE_UPDATE_NODE            EVENT_TYPE = 102
-	EVENT_TYPE_REMOVE_NODE            EVENT_TYPE = 103
-	EVENT_TYPE_CREATE_CONTAINER       EVENT_TYPE = 201
-	EVENT_TYPE_START_CONTAINER        EVENT_TYPE = 202
-	EVENT_TYPE_STOP_CONTAINER         EVENT_TYPE = 203
-	EVENT_TYPE_REMOVE_CONTAINER       EVENT_TYPE = 204
-	EVENT_TYPE_RESTART_CONTAINER      EVENT_TYPE = 205
-	EVENT_TYPE_UPLOAD_IMAGE           EVENT_TYPE = 301
-	EVENT_TYPE_DOWNLOAD_IMAGE         EVENT_TYPE = 302
-	EVENT_TYPE_APPROVE_IMAGE          EVENT_TYPE = 303
-	EVENT_TYPE_UPDATE_IMAGE           EVENT_TYPE = 304
-	EVENT_TYPE_REMOVE_IMAGE           EVENT_TYPE = 305
-	EVENT_TYPE_USER_LOGIN             EVENT_TYPE = 401
-	EVENT_TYPE_USER_LOGOUT            EVENT_TYPE = 402
-	EVENT_TYPE_CREATE_USER            EVENT_TYPE = 403
-	EVENT_TYPE_UPDATE_USER            EVENT_TYPE = 404
-	EVENT_TYPE_REMOVE_USER            EVENT_TYPE = 405
-	EVENT_TYPE_CREATE_ROLE            EVENT_TYPE = 406
-	EVENT_TYPE_UPDATE_ROLE            EVENT_TYPE = 407
-	EVENT_TYPE_REMOVE_ROLE            EVENT_TYPE = 408
-	EVENT_TYPE_UPDATE_PASSWORD        EVENT_TYPE = 409
-	EVENT_TYPE_WARN_RESOURCE_USAGE    EVENT_TYPE = 1001
-	EVENT_TYPE_WARN_NODE_OFFLINE      EVENT_TYPE = 1002
-	EVENT_TYPE_WARN_ILLEGAL_CONTAINER EVENT_TYPE = 1003
-	EVENT_TYPE_WARN_NODE_ABNORMAL     EVENT_TYPE = 1004
+	EVENT_TYPE_TYPE_NONE                      EVENT_TYPE = 0
+	EVENT_TYPE_CREATE_NODE                    EVENT_TYPE = 101
+	EVENT_TYPE_UPDATE_NODE                    EVENT_TYPE = 102
+	EVENT_TYPE_REMOVE_NODE                    EVENT_TYPE = 103
+	EVENT_TYPE_CREATE_CONTAINER               EVENT_TYPE = 201
+	EVENT_TYPE_START_CONTAINER                EVENT_TYPE = 202
+	EVENT_TYPE_STOP_CONTAINER                 EVENT_TYPE = 203
+	EVENT_TYPE_REMOVE_CONTAINER               EVENT_TYPE = 204
+	EVENT_TYPE_RESTART_CONTAINER              EVENT_TYPE = 205
+	EVENT_TYPE_EXEC_CONTAINER                 EVENT_TYPE = 206
+	EVENT_TYPE_MIGRATE_CONTAINER              EVENT_TYPE = 207
+	EVENT_TYPE_FAILOVER_CONTAINER             EVENT_TYPE = 208
+	EVENT_TYPE_DIE_CONTAINER                  EVENT_TYPE = 209 // 容器进程退出(非控制器发起)
+	EVENT_TYPE_CLI_CONTAINER_ACTION           EVENT_TYPE = 210 // 控制器以外发起的容器操作
+	EVENT_TYPE_SCHEDULED_BACKUP               EVENT_TYPE = 211 // 定时备份
+	EVENT_TYPE_PRUNE_BACKUP                   EVENT_TYPE = 212 // 按保留策略清理备份
+	EVENT_TYPE_UPLOAD_IMAGE                   EVENT_TYPE = 301
+	EVENT_TYPE_DOWNLOAD_IMAGE                 EVENT_TYPE = 302
+	EVENT_TYPE_APPROVE_IMAGE                  EVENT_TYPE = 303
+	EVENT_TYPE_UPDATE_IMAGE                   EVENT_TYPE = 304
+	EVENT_TYPE_REMOVE_IMAGE                   EVENT_TYPE = 305
+	EVENT_TYPE_USER_LOGIN                     EVENT_TYPE = 401
+	EVENT_TYPE_USER_LOGOUT                    EVENT_TYPE = 402
+	EVENT_TYPE_CREATE_USER                    EVENT_TYPE = 403
+	EVENT_TYPE_UPDATE_USER                    EVENT_TYPE = 404
+	EVENT_TYPE_REMOVE_USER                    EVENT_TYPE = 405
+	EVENT_TYPE_CREATE_ROLE                    EVENT_TYPE = 406
+	EVENT_TYPE_UPDATE_ROLE                    EVENT_TYPE = 407
+	EVENT_TYPE_REMOVE_ROLE                    EVENT_TYPE = 408
+	EVENT_TYPE_UPDATE_PASSWORD                EVENT_TYPE = 409
+	EVENT_TYPE_WARN_RESOURCE_USAGE            EVENT_TYPE = 1001
+	EVENT_TYPE_WARN_NODE_OFFLINE              EVENT_TYPE = 1002
+	EVENT_TYPE_WARN_ILLEGAL_CONTAINER         EVENT_TYPE = 1003
+	EVENT_TYPE_WARN_NODE_ABNORMAL             EVENT_TYPE = 1004
+	EVENT_TYPE_WARN_CONTAINER_FAILOVER        EVENT_TYPE = 1005
+	EVENT_TYPE_WARN_CONTAINER_UNHEALTHY       EVENT_TYPE = 1006
+	EVENT_TYPE_WARN_CONTAINER_OOM             EVENT_TYPE = 1007
+	EVENT_TYPE_WARN_CONTAINER_UNEXPECTED_EXIT EVENT_TYPE = 1008
+	EVENT_TYPE_WARN_CONTAINER_FILE_TAMPERED   EVENT_TYPE = 1009
+	EVENT_TYPE_WARN_CONTAINER_EVENTS_DROPPED  EVENT_TYPE = 1010
 )
 
 // Enum value maps for EVENT_TYPE.
@@ -124,6 +137,13 @@ var (
 		203:  "STOP_CONTAINER",
 		204:  "REMOVE_CONTAINER",
 		205:  "RESTART_CONTAINER",
+		206:  "EXEC_CONTAINER",
+		207:  "MIGRATE_CONTAINER",
+		208:  "FAILOVER_CONTAINER",
+		209:  "DIE_CONTAINER",
+		210:  "CLI_CONTAINER_ACTION",
+		211:  "SCHEDULED_BACKUP",
+		212:  "PRUNE_BACKUP",
 		301:  "UPLOAD_IMAGE",
 		302:  "DOWNLOAD_IMAGE",
 		303:  "APPROVE_IMAGE",
@@ -142,35 +162,54 @@ var (
 		1002: "WARN_NODE_OFFLINE",
 		1003: "WARN_ILLEGAL_CONTAINER",
 		1004: "WARN_NODE_ABNORMAL",
+		1005: "WARN_CONTAINER_FAILOVER",
+		1006: "WARN_CONTAINER_UNHEALTHY",
+		1007: "WARN_CONTAINER_OOM",
+		1008: "WARN_CONTAINER_UNEXPECTED_EXIT",
+		1009: "WARN_CONTAINER_FILE_TAMPERED",
+		1010: "WARN_CONTAINER_EVENTS_DROPPED",
 	}
 	EVENT_TYPE_value = map[string]int32{
-		"TYPE_NONE":              0,
-		"CREATE_NODE":            101,
-		"UPDATE_NODE":            102,
-		"REMOVE_NODE":            103,
-		"CREATE_CONTAINER":       201,
-		"START_CONTAINER":        202,
-		"STOP_CONTAINER":         203,
-		"REMOVE_CONTAINER":       204,
-		"RESTART_CONTAINER":      205,
-		"UPLOAD_IMAGE":           301,
-		"DOWNLOAD_IMAGE":         302,
-		"APPROVE_IMAGE":          303,
-		"UPDATE_IMAGE":           304,
-		"REMOVE_IMAGE":           305,
-		"USER_LOGIN":             401,
-		"USER_LOGOUT":            402,
-		"CREATE_USER":            403,
-		"UPDATE_USER":            404,
-		"REMOVE_USER":            405,
-		"CREATE_ROLE":            406,
-		"UPDATE_ROLE":            407,
-		"REMOVE_ROLE":            408,
-		"UPDATE_PASSWORD":        409,
-		"WARN_RESOURCE_USAGE":    1001,
-		"WARN_NODE_OFFLINE":      1002,
-		"WARN_ILLEGAL_CONTAINER": 1003,
-		"WARN_NODE_ABNORMAL":     1004,
+		"TYPE_NONE":                      0,
+		"CREATE_NODE":                    101,
+		"UPDATE_NODE":                    102,
+		"REMOVE_NODE":                    103,
+		"CREATE_CONTAINER":               201,
+		"START_CONTAINER":                202,
+		"STOP_CONTAINER":                 203,
+		"REMOVE_CONTAINER":               204,
+		"RESTART_CONTAINER":              205,
+		"EXEC_CONTAINER":                 206,
+		"MIGRATE_CONTAINER":              207,
+		"FAILOVER_CONTAINER":             208,
+		"DIE_CONTAINER":                  209,
+		"CLI_CONTAINER_ACTION":           210,
+		"SCHEDULED_BACKUP":               211,
+		"PRUNE_BACKUP":                   212,
+		"UPLOAD_IMAGE":                   301,
+		"DOWNLOAD_IMAGE":                 302,
+		"APPROVE_IMAGE":                  303,
+		"UPDATE_IMAGE":                   304,
+		"REMOVE_IMAGE":                   305,
+		"USER_LOGIN":                     401,
+		"USER_LOGOUT":                    402,
+		"CREATE_USER":                    403,
+		"UPDATE_USER":                    404,
+		"REMOVE_USER":                    405,
+		"CREATE_ROLE":                    406,
+		"UPDATE_ROLE":                    407,
+		"REMOVE_ROLE":                    408,
+		"UPDATE_PASSWORD":                409,
+		"WARN_RESOURCE_USAGE":            1001,
+		"WARN_NODE_OFFLINE":              1002,
+		"WARN_ILLEGAL_CONTAINER":         1003,
+		"WARN_NODE_ABNORMAL":             1004,
+		"WARN_CONTAINER_FAILOVER":        1005,
+		"WARN_CONTAINER_UNHEALTHY":       1006,
+		"WARN_CONTAINER_OOM":             1007,
+		"WARN_CONTAINER_UNEXPECTED_EXIT": 1008,
+		"WARN_CONTAINER_FILE_TAMPERED":   1009,
+		"WARN_CONTAINER_EVENTS_DROPPED":  1010,
 	}
 )
 
@@ -1100,7 +1139,7 @@ var file_logging_proto_rawDesc = []byte{
 	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
 	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49,
 	0x4d, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04,
-	0x2a, 0xae, 0x04, 0x0a, 0x0a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12,
+	0x2a, 0x8f, 0x07, 0x0a, 0x0a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12,
 	0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0f,
 	0x0a, 0x0b, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x65, 0x12,
 	0x0f, 0x0a, 0x0b, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x66,
@@ -1112,45 +1151,67 @@ var file_logging_proto_rawDesc = []byte{
 	0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x4f,
 	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0xcc, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x45,
 	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10,
-	0xcd, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x49, 0x4d, 0x41,
-	0x47, 0x45, 0x10, 0xad, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41,
-	0x44, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xae, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x41, 0x50,
-	0x50, 0x52, 0x4f, 0x56, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xaf, 0x02, 0x12, 0x11,
-	0x0a, 0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xb0,
-	0x02, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47,
-	0x45, 0x10, 0xb1, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47,
-	0x49, 0x4e, 0x10, 0x91, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f,
-	0x47, 0x4f, 0x55, 0x54, 0x10, 0x92, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x93, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x94, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x52,
-	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x95, 0x03, 0x12, 0x10, 0x0a,
-	0x0b, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x96, 0x03, 0x12,
-	0x10, 0x0a, 0x0b, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x97,
-	0x03, 0x12, 0x10, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
-	0x10, 0x98, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41,
-	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x99, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x52,
-	0x4e, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45,
-	0x10, 0xe9, 0x07, 0x12, 0x16, 0x0a, 0x11, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
-	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0xea, 0x07, 0x12, 0x1b, 0x0a, 0x16, 0x57,
-	0x41, 0x52, 0x4e, 0x5f, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0xeb, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x52, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x42, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0xec,
-	0x07, 0x32, 0xd2, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a,
-	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x6c,
-	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61,
-	0x72, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x61, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
-	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x57, 0x61,
-	0x72, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x57, 0x61, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
-	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x63, 0x6d, 0x63, 0x2f, 0x72,
-	0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xcd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x10, 0xce, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x4d, 0x49, 0x47, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0xcf, 0x01, 0x12,
+	0x17, 0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0xd0, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x44, 0x49, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0xd1, 0x01, 0x12, 0x19, 0x0a, 0x14,
+	0x43, 0x4c, 0x49, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xd2, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0xd3, 0x01, 0x12, 0x11,
+	0x0a, 0x0c, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0xd4,
+	0x01, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x10, 0xad, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44,
+	0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xae, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xaf, 0x02, 0x12, 0x11, 0x0a,
+	0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xb0, 0x02,
+	0x12, 0x11, 0x0a, 0x0c, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45,
+	0x10, 0xb1, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49,
+	0x4e, 0x10, 0x91, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47,
+	0x4f, 0x55, 0x54, 0x10, 0x92, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x93, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x94, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x95, 0x03, 0x12, 0x10, 0x0a, 0x0b,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x96, 0x03, 0x12, 0x10,
+	0x0a, 0x0b, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x97, 0x03,
+	0x12, 0x10, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10,
+	0x98, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x99, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x57, 0x41, 0x52, 0x4e,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0xe9, 0x07, 0x12, 0x16, 0x0a, 0x11, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
+	0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0xea, 0x07, 0x12, 0x1b, 0x0a, 0x16, 0x57, 0x41,
+	0x52, 0x4e, 0x5f, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x10, 0xeb, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x57, 0x41, 0x52, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x42, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0xec, 0x07,
+	0x12, 0x1c, 0x0a, 0x17, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x10, 0xed, 0x07, 0x12, 0x1d,
+	0x0a, 0x18, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0xee, 0x07, 0x12, 0x17, 0x0a,
+	0x12, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f,
+	0x4f, 0x4f, 0x4d, 0x10, 0xef, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0xf0, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x57,
+	0x41, 0x52, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x54, 0x41, 0x4d, 0x50, 0x45, 0x52, 0x45, 0x44, 0x10, 0xf1, 0x07, 0x12, 0x22,
+	0x0a, 0x1d, 0x57, 0x41, 0x52, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0xf2, 0x07, 0x32, 0xd2, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x47,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x72, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x72, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x57,
+	0x61, 0x72, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x61, 0x72, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x63, 0x6d, 0x63, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
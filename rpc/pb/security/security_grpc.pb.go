@@ -25,6 +25,13 @@ type SecurityClient interface {
 	ListFileProtection(ctx context.Context, in *ListFileProtectionRequest, opts ...grpc.CallOption) (*ListFileProtectionReply, error)
 	UpdateFileProtection(ctx context.Context, in *UpdateFileProtectionRequest, opts ...grpc.CallOption) (*UpdateFileProtectionReply, error)
 	LoadSecurityConfig(ctx context.Context, in *LoadSecurityConfigRequset, opts ...grpc.CallOption) (*LoadSecurityConfigReply, error)
+	// seccomp配置
+	ListSeccompProfile(ctx context.Context, in *ListSeccompProfileRequest, opts ...grpc.CallOption) (*ListSeccompProfileReply, error)
+	CreateSeccompProfile(ctx context.Context, in *CreateSeccompProfileRequest, opts ...grpc.CallOption) (*CreateSeccompProfileReply, error)
+	UpdateSeccompProfile(ctx context.Context, in *UpdateSeccompProfileRequest, opts ...grpc.CallOption) (*UpdateSeccompProfileReply, error)
+	RemoveSeccompProfile(ctx context.Context, in *RemoveSeccompProfileRequest, opts ...grpc.CallOption) (*RemoveSeccompProfileReply, error)
+	// seccomp配置内部接口 控制器下发配置至agent
+	SyncSeccompProfile(ctx context.Context, in *SyncSeccompProfileRequest, opts ...grpc.CallOption) (*SyncSeccompProfileReply, error)
 }
 
 type securityClient struct {
@@ -80,6 +87,51 @@ func (c *securityClient) LoadSecurityConfig(ctx context.Context, in *LoadSecurit
 	return out, nil
 }
 
+func (c *securityClient) ListSeccompProfile(ctx context.Context, in *ListSeccompProfileRequest, opts ...grpc.CallOption) (*ListSeccompProfileReply, error) {
+	out := new(ListSeccompProfileReply)
+	err := c.cc.Invoke(ctx, "/security.Security/ListSeccompProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityClient) CreateSeccompProfile(ctx context.Context, in *CreateSeccompProfileRequest, opts ...grpc.CallOption) (*CreateSeccompProfileReply, error) {
+	out := new(CreateSeccompProfileReply)
+	err := c.cc.Invoke(ctx, "/security.Security/CreateSeccompProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityClient) UpdateSeccompProfile(ctx context.Context, in *UpdateSeccompProfileRequest, opts ...grpc.CallOption) (*UpdateSeccompProfileReply, error) {
+	out := new(UpdateSeccompProfileReply)
+	err := c.cc.Invoke(ctx, "/security.Security/UpdateSeccompProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityClient) RemoveSeccompProfile(ctx context.Context, in *RemoveSeccompProfileRequest, opts ...grpc.CallOption) (*RemoveSeccompProfileReply, error) {
+	out := new(RemoveSeccompProfileReply)
+	err := c.cc.Invoke(ctx, "/security.Security/RemoveSeccompProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityClient) SyncSeccompProfile(ctx context.Context, in *SyncSeccompProfileRequest, opts ...grpc.CallOption) (*SyncSeccompProfileReply, error) {
+	out := new(SyncSeccompProfileReply)
+	err := c.cc.Invoke(ctx, "/security.Security/SyncSeccompProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecurityServer is the server API for Security service.
 // All implementations must embed UnimplementedSecurityServer
 // for forward compatibility
@@ -91,6 +143,13 @@ type SecurityServer interface {
 	ListFileProtection(context.Context, *ListFileProtectionRequest) (*ListFileProtectionReply, error)
 	UpdateFileProtection(context.Context, *UpdateFileProtectionRequest) (*UpdateFileProtectionReply, error)
 	LoadSecurityConfig(context.Context, *LoadSecurityConfigRequset) (*LoadSecurityConfigReply, error)
+	// seccomp配置
+	ListSeccompProfile(context.Context, *ListSeccompProfileRequest) (*ListSeccompProfileReply, error)
+	CreateSeccompProfile(context.Context, *CreateSeccompProfileRequest) (*CreateSeccompProfileReply, error)
+	UpdateSeccompProfile(context.Context, *UpdateSeccompProfileRequest) (*UpdateSeccompProfileReply, error)
+	RemoveSeccompProfile(context.Context, *RemoveSeccompProfileRequest) (*RemoveSeccompProfileReply, error)
+	// seccomp配置内部接口 控制器下发配置至agent
+	SyncSeccompProfile(context.Context, *SyncSeccompProfileRequest) (*SyncSeccompProfileReply, error)
 	mustEmbedUnimplementedSecurityServer()
 }
 
@@ -113,6 +172,21 @@ func (UnimplementedSecurityServer) UpdateFileProtection(context.Context, *Update
 func (UnimplementedSecurityServer) LoadSecurityConfig(context.Context, *LoadSecurityConfigRequset) (*LoadSecurityConfigReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadSecurityConfig not implemented")
 }
+func (UnimplementedSecurityServer) ListSeccompProfile(context.Context, *ListSeccompProfileRequest) (*ListSeccompProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeccompProfile not implemented")
+}
+func (UnimplementedSecurityServer) CreateSeccompProfile(context.Context, *CreateSeccompProfileRequest) (*CreateSeccompProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeccompProfile not implemented")
+}
+func (UnimplementedSecurityServer) UpdateSeccompProfile(context.Context, *UpdateSeccompProfileRequest) (*UpdateSeccompProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeccompProfile not implemented")
+}
+func (UnimplementedSecurityServer) RemoveSeccompProfile(context.Context, *RemoveSeccompProfileRequest) (*RemoveSeccompProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeccompProfile not implemented")
+}
+func (UnimplementedSecurityServer) SyncSeccompProfile(context.Context, *SyncSeccompProfileRequest) (*SyncSeccompProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSeccompProfile not implemented")
+}
 func (UnimplementedSecurityServer) mustEmbedUnimplementedSecurityServer() {}
 
 // UnsafeSecurityServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +290,96 @@ func _Security_LoadSecurityConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Security_ListSeccompProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeccompProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServer).ListSeccompProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Security/ListSeccompProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServer).ListSeccompProfile(ctx, req.(*ListSeccompProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Security_CreateSeccompProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeccompProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServer).CreateSeccompProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Security/CreateSeccompProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServer).CreateSeccompProfile(ctx, req.(*CreateSeccompProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Security_UpdateSeccompProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeccompProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServer).UpdateSeccompProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Security/UpdateSeccompProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServer).UpdateSeccompProfile(ctx, req.(*UpdateSeccompProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Security_RemoveSeccompProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSeccompProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServer).RemoveSeccompProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Security/RemoveSeccompProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServer).RemoveSeccompProfile(ctx, req.(*RemoveSeccompProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Security_SyncSeccompProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSeccompProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServer).SyncSeccompProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Security/SyncSeccompProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServer).SyncSeccompProfile(ctx, req.(*SyncSeccompProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Security_ServiceDesc is the grpc.ServiceDesc for Security service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +407,26 @@ var Security_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoadSecurityConfig",
 			Handler:    _Security_LoadSecurityConfig_Handler,
 		},
+		{
+			MethodName: "ListSeccompProfile",
+			Handler:    _Security_ListSeccompProfile_Handler,
+		},
+		{
+			MethodName: "CreateSeccompProfile",
+			Handler:    _Security_CreateSeccompProfile_Handler,
+		},
+		{
+			MethodName: "UpdateSeccompProfile",
+			Handler:    _Security_UpdateSeccompProfile_Handler,
+		},
+		{
+			MethodName: "RemoveSeccompProfile",
+			Handler:    _Security_RemoveSeccompProfile_Handler,
+		},
+		{
+			MethodName: "SyncSeccompProfile",
+			Handler:    _Security_SyncSeccompProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "security.proto",
@@ -154,4 +154,109 @@ AFTER `disk_limit`;
 
 ALTER TABLE `container_templates`
 ADD COLUMN `node_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '节点ID'
-AFTER `name`;
\ No newline at end of file
+AFTER `name`;
+
+ALTER TABLE `container_configs`
+ADD COLUMN `configs` TEXT NULL COMMENT '容器配置 以JSON形式存储 用于故障转移重建 旧记录为空'
+AFTER `security_config`,
+ADD COLUMN `ha_enabled` TINYINT NOT NULL DEFAULT 0 COMMENT '高可用 0:关闭 1:开启'
+AFTER `configs`;
+
+ALTER TABLE `node_infos`
+ADD COLUMN `allowed_devices` TEXT NOT NULL COMMENT '允许直通到容器的主机设备路径 逗号分隔'
+AFTER `deleted`;
+
+CREATE TABLE IF NOT EXISTS `seccomp_profiles` (
+  `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(255) NOT NULL UNIQUE DEFAULT '' COMMENT '配置名',
+  `content` MEDIUMTEXT NOT NULL COMMENT 'docker seccomp配置 JSON',
+  `desc` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '描述',
+  `created_at` INT(20) NOT NULL DEFAULT 0,
+  `updated_at` INT(20) NOT NULL DEFAULT 0
+) ENGINE=InnoDB AUTO_INCREMENT=1;
+
+CREATE TABLE IF NOT EXISTS `container_imports` (
+  `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `image_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '导入生成的镜像ID',
+  `node_id` BIGINT(20) NOT NULL DEFAULT 0,
+  `configs` TEXT NOT NULL COMMENT '容器配置 以JSON形式存储',
+  `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0:等待镜像审批 1:已创建容器 2:失败',
+  `container_id` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'docker容器ID',
+  `attempts` INT(10) NOT NULL DEFAULT 0 COMMENT '创建容器尝试次数',
+  `reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+  `created_at` INT(20) NOT NULL DEFAULT 0,
+  `updated_at` INT(20) NOT NULL DEFAULT 0
+) ENGINE=InnoDB AUTO_INCREMENT=1;
+
+ALTER TABLE `container_configs`
+ADD COLUMN `creator` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '创建者用户ID'
+AFTER `ha_enabled`;
+
+ALTER TABLE `container_imports`
+ADD COLUMN `creator` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '导入者用户ID'
+AFTER `reason`;
+
+ALTER TABLE `user_roles`
+ADD COLUMN `owned_containers_only` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '仅可访问自己创建或共享给自己的容器'
+AFTER `perms_json`;
+
+ALTER TABLE `container_configs`
+ADD COLUMN `shared_users` TEXT NOT NULL COMMENT '共享用户ID 逗号分隔'
+AFTER `creator`;
+
+ALTER TABLE `container_templates`
+ADD COLUMN `version` BIGINT(20) NOT NULL DEFAULT 1 COMMENT '模板版本 每次更新加1'
+AFTER `node_id`;
+
+ALTER TABLE `container_configs`
+ADD COLUMN `template_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '使用模板创建时的模板ID'
+AFTER `shared_users`,
+ADD COLUMN `template_version` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '使用模板创建时的模板版本'
+AFTER `template_id`;
+
+CREATE TABLE IF NOT EXISTS `container_template_revisions` (
+  `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `template_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '模板ID',
+  `version` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '模板版本',
+  `name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '模板名',
+  `node_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '节点ID',
+  `config_json` TEXT NOT NULL COMMENT '配置参数 以JSON形式存储',
+  `created_at` INT(20) NOT NULL DEFAULT 0,
+  UNIQUE KEY `template_version` (`template_id`, `version`)
+) ENGINE=InnoDB AUTO_INCREMENT=1;
+
+ALTER TABLE `container_backups`
+ADD COLUMN `schedule_id` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '定时备份ID 手动备份为0'
+AFTER `status`;
+
+CREATE TABLE IF NOT EXISTS `backup_schedules` (
+  `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uuid` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '容器UUID',
+  `cron` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'cron表达式',
+  `keep_count` INT(10) NOT NULL DEFAULT 0 COMMENT '保留最近的备份数 0:不限制',
+  `keep_days` INT(10) NOT NULL DEFAULT 0 COMMENT '备份保留天数 0:不限制',
+  `desc_template` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备份描述模板',
+  `disabled` TINYINT(1) NOT NULL DEFAULT 0,
+  `last_run_at` INT(20) NOT NULL DEFAULT 0 COMMENT '最近一次执行时间',
+  `last_error` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最近一次执行失败原因',
+  `next_run_at` INT(20) NOT NULL DEFAULT 0 COMMENT '下次执行时间',
+  `creator` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '创建者用户ID',
+  `created_at` INT(20) NOT NULL DEFAULT 0,
+  `updated_at` INT(20) NOT NULL DEFAULT 0
+) ENGINE=InnoDB AUTO_INCREMENT=1;
+
+ALTER TABLE `container_backups`
+ADD COLUMN `registry_ref` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '仓库中的备份镜像repo:tag 为空表示未推送'
+AFTER `schedule_id`,
+ADD COLUMN `push_attempts` INT(10) NOT NULL DEFAULT 0 COMMENT '推送至仓库的尝试次数'
+AFTER `registry_ref`;
+
+ALTER TABLE `container_backups`
+ADD COLUMN `archives` TEXT COMMENT '挂载数据归档列表 JSON'
+AFTER `push_attempts`,
+ADD COLUMN `archive_size` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '挂载数据归档总大小'
+AFTER `archives`;
+
+ALTER TABLE `backup_schedules`
+ADD COLUMN `with_mounts` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '同时备份挂载数据'
+AFTER `desc_template`;
@@ -11,6 +11,7 @@ import (
 	"scmc/rpc/pb/network"
 	"scmc/rpc/pb/node"
 	"scmc/rpc/pb/security"
+	"scmc/rpc/pb/volume"
 	"scmc/server"
 	"scmc/server/agent/internal"
 )
@@ -38,10 +39,13 @@ func Server() (*grpc.Server, error) {
 	network.RegisterNetworkServer(s, &internal.NetworkServer{})
 	node.RegisterNodeServer(s, &internal.NodeServer{})
 	security.RegisterSecurityServer(s, &internal.SecurityServer{})
+	volume.RegisterVolumeServer(s, &internal.VolumeServer{})
 
 	go internal.NodeWhitelistConfig()
 	go internal.ContainerWhiteCongig()
 	go internal.CPUUsageProbe()
+	go internal.ContainerEventWatcher()
+	internal.BackupJobWorker()
 
 	return s, nil
 }
@@ -0,0 +1,220 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+)
+
+const backupChunkSize = 1024 * 1024
+
+// 发送单个文件 首个分块包含文件名和大小
+func sendBackupFile(stream pb.Container_SaveBackupServer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Warnf("open backup file=%v err=%v", path, err)
+		return rpc.ErrInternal
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	chunk := pb.BackupFileChunk{FileName: name, FileSize: fi.Size()}
+	buffer := make([]byte, backupChunkSize)
+	for {
+		n, err := io.ReadFull(f, buffer)
+		if n > 0 || chunk.FileName != "" {
+			chunk.ChunkData = buffer[:n]
+			if err := stream.Send(&chunk); err != nil {
+				log.Infof("SaveBackup send file=%v err=%v", name, err)
+				return err
+			}
+			chunk = pb.BackupFileChunk{}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			log.Warnf("SaveBackup read file=%v err=%v", path, err)
+			return rpc.ErrInternal
+		}
+	}
+}
+
+func (s *ContainerServer) SaveBackup(in *pb.SaveBackupRequest, stream pb.Container_SaveBackupServer) error {
+	if in.ImageRef == "" {
+		return rpc.ErrInvalidArgument
+	}
+	for _, a := range in.Archives {
+		if !model.IsBackupArchiveFile(a.File) {
+			return rpc.ErrInvalidArgument
+		}
+	}
+
+	imageFile, err := model.SaveImageFile(stream.Context(), in.ImageRef)
+	if err != nil {
+		return transDockerError(err)
+	}
+	defer os.Remove(imageFile)
+
+	if err := sendBackupFile(stream, model.BackupImageFile, imageFile); err != nil {
+		return err
+	}
+	for _, a := range in.Archives {
+		if err := sendBackupFile(stream, model.BackupMountsDir+filepath.Base(a.File), a.File); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 从请求流中读取单个文件的数据
+type backupFileReader struct {
+	stream    pb.Container_LoadBackupServer
+	buf       []byte
+	remaining int64 // 尚未接收的字节数
+}
+
+func (r *backupFileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.remaining <= 0 {
+			return 0, io.EOF
+		}
+
+		req, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		} else if err != nil {
+			return 0, err
+		} else if req.Chunk == nil || req.Chunk.FileName != "" {
+			return 0, status.Errorf(codes.InvalidArgument, "文件数据不完整")
+		}
+		r.buf = req.Chunk.ChunkData
+		r.remaining -= int64(len(r.buf))
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s *ContainerServer) LoadBackup(stream pb.Container_LoadBackupServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		log.Errorf("cannot receive load backup info %v", err)
+		return rpc.ErrUnknown
+	}
+
+	backupName, imageRef := req.BackupName, req.ImageRef
+	if backupName == "" || imageRef == "" {
+		return rpc.ErrInvalidArgument
+	}
+
+	var reply pb.LoadBackupReply
+	newImage, err := loadBackupFiles(stream, req, backupName, imageRef, &reply)
+	if err != nil {
+		cleanLoadedBackup(backupName, imageRef, newImage)
+		return err
+	}
+
+	// 控制器未收到结果时不会登记备份
+	if err := stream.SendAndClose(&reply); err != nil {
+		log.Infof("LoadBackup send reply err=%v", err)
+		cleanLoadedBackup(backupName, imageRef, newImage)
+		return err
+	}
+	return nil
+}
+
+// 加载失败时删除写入的归档, 以及加载前节点上不存在的镜像
+func cleanLoadedBackup(backupName, imageRef string, newImage bool) {
+	if newImage {
+		if cli, err := model.DockerClient(); err == nil {
+			if _, err := cli.ImageRemove(context.Background(), imageRef, types.ImageRemoveOptions{}); err != nil && !client.IsErrNotFound(err) {
+				log.Infof("remove loaded backup image=%v err=%v", imageRef, err)
+			}
+		}
+	}
+
+	if err := model.RemoveBackupArchives(backupName); err != nil {
+		log.Infof("remove backup=%v archives err=%v", backupName, err)
+	}
+}
+
+// 节点上是否已有该镜像 查询失败时视为存在
+func localImageExists(imageRef string) bool {
+	cli, err := model.DockerClient()
+	if err != nil {
+		return true
+	}
+
+	_, _, err = cli.ImageInspectWithRaw(context.Background(), imageRef)
+	return !client.IsErrNotFound(err)
+}
+
+// 接收备份文件, newImage表示镜像由本次请求加载
+func loadBackupFiles(stream pb.Container_LoadBackupServer, req *pb.LoadBackupRequest, backupName, imageRef string, reply *pb.LoadBackupReply) (newImage bool, err error) {
+	var imageLoaded bool
+	for ; err == nil; req, err = stream.Recv() {
+		c := req.Chunk
+		if c == nil || c.FileName == "" {
+			return newImage, rpc.ErrInvalidArgument
+		}
+
+		r := &backupFileReader{stream: stream, buf: c.ChunkData, remaining: c.FileSize - int64(len(c.ChunkData))}
+		switch {
+		case c.FileName == model.BackupImageFile:
+			newImage = !localImageExists(imageRef)
+			if reply.ImageId, reply.ImageSize, err = model.LoadImage(stream.Context(), r, imageRef); err != nil {
+				return newImage, status.Errorf(codes.Internal, "加载备份镜像失败")
+			}
+			imageLoaded = true
+		case strings.HasPrefix(c.FileName, model.BackupMountsDir) && req.Archive != nil:
+			a, err := model.WriteBackupArchive(backupName, c.FileName, r)
+			if err != nil {
+				log.Warnf("write backup=%v archive=%v err=%v", backupName, c.FileName, err)
+				return newImage, rpc.ErrInternal
+			} else if a.Sha256 != req.Archive.Sha256 {
+				return newImage, status.Errorf(codes.DataLoss, "挂载数据归档校验失败")
+			}
+			reply.Archives = append(reply.Archives, &pb.BackupArchive{
+				Destination: req.Archive.Destination,
+				Type:        req.Archive.Type,
+				File:        a.File,
+				Size:        a.Size,
+				Sha256:      a.Sha256,
+			})
+		default:
+			return newImage, rpc.ErrInvalidArgument
+		}
+
+		// 丢弃未读取的数据 下一个请求为下一个文件
+		if _, err := io.Copy(ioutil.Discard, r); err != nil {
+			return newImage, err
+		}
+	}
+	if err != io.EOF {
+		log.Infof("LoadBackup receive err=%v", err)
+		return newImage, rpc.ErrUnknown
+	} else if !imageLoaded {
+		return newImage, status.Errorf(codes.InvalidArgument, "缺少备份镜像")
+	}
+
+	return newImage, nil
+}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+)
+
+const (
+	maxContainerEvents = 1000
+	// 接口操作前后该时间范围内的容器事件视为接口发起
+	operationEventWindow = time.Second * 10
+)
+
+// 需要上报的docker容器事件
+var watchedContainerActions = map[string]bool{
+	"create":  true,
+	"start":   true,
+	"restart": true,
+	"stop":    true,
+	"kill":    true,
+	"die":     true,
+	"oom":     true,
+	"destroy": true,
+	"update":  true,
+}
+
+var globalContainerEvents = containerEvents{
+	epoch:      uuid.New().String(),
+	gapID:      -1,
+	operations: make(map[string]time.Time),
+}
+
+type containerEvents struct {
+	sync.Mutex
+
+	epoch      string // 启动标识 事件id仅在同一启动标识内有效
+	lastID     int64
+	gapID      int64 // 最近一次事件流中断时跳过的事件id
+	events     []*pb.ContainerEvent
+	operations map[string]time.Time // 容器最近一次接口操作时间
+}
+
+func (c *containerEvents) append(e *pb.ContainerEvent) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.lastID++
+	e.Id = c.lastID
+	c.events = append(c.events, e)
+	if len(c.events) > maxContainerEvents {
+		c.events = c.events[len(c.events)-maxContainerEvents:]
+	}
+}
+
+// docker事件流中断期间的事件已丢失 跳过一个事件id, 查询时报告为缺失
+func (c *containerEvents) markGap() {
+	c.Lock()
+	defer c.Unlock()
+
+	// 中断后还没有新事件 不重复跳过
+	if c.gapID == c.lastID {
+		return
+	}
+	c.lastID++
+	c.gapID = c.lastID
+}
+
+// 记录接口对容器的操作 用于区分命令行等外部发起的事件
+func (c *containerEvents) markOperation(ids ...string) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	for _, id := range ids {
+		c.operations[id] = now
+	}
+
+	for id, t := range c.operations {
+		if now.Sub(t) > time.Hour {
+			delete(c.operations, id)
+		}
+	}
+}
+
+func (c *containerEvents) isExternal(e *pb.ContainerEvent) bool {
+	t, ok := c.operations[e.ContainerId]
+	if !ok {
+		return true
+	}
+
+	d := time.Unix(e.Time, 0).Sub(t)
+	return d < -operationEventWindow || d > operationEventWindow
+}
+
+func (c *containerEvents) list(epoch string, sinceID int64) *pb.EventsReply {
+	c.Lock()
+	defer c.Unlock()
+
+	r := pb.EventsReply{LastId: c.lastID, Epoch: c.epoch}
+	sameEpoch := epoch == c.epoch
+	if !sameEpoch {
+		sinceID = 0
+	}
+
+	for _, e := range c.events {
+		if e.Id <= sinceID {
+			continue
+		}
+		// 查询时再判断 避免事件先于操作记录到达
+		ev := proto.Clone(e).(*pb.ContainerEvent)
+		ev.External = c.isExternal(e)
+		r.Events = append(r.Events, ev)
+	}
+	// 事件被淘汰或事件流中断跳过的id 都会使返回的事件少于id区间
+	if sameEpoch && int64(len(r.Events)) < c.lastID-sinceID {
+		r.Gap = true
+	}
+	return &r
+}
+
+func toContainerEvent(m events.Message) *pb.ContainerEvent {
+	e := &pb.ContainerEvent{
+		ContainerId:   m.Actor.ID,
+		ContainerName: m.Actor.Attributes["name"],
+		Action:        m.Action,
+		Time:          m.Time,
+	}
+
+	if v, ok := m.Actor.Attributes["exitCode"]; ok {
+		if code, err := strconv.Atoi(v); err == nil {
+			e.ExitCode = int32(code)
+		}
+	}
+	return e
+}
+
+func watchContainerEvents() error {
+	cli, err := model.DockerClient()
+	if err != nil {
+		return err
+	}
+
+	opts := types.EventsOptions{
+		Filters: filters.NewArgs(filters.Arg("type", "container")),
+	}
+	msgs, errs := cli.Events(context.Background(), opts)
+	for {
+		select {
+		case m := <-msgs:
+			if m.Action == "update" {
+				forgetContainerResource(m.Actor.ID)
+			}
+			if watchedContainerActions[m.Action] {
+				globalContainerEvents.append(toContainerEvent(m))
+			}
+		case err := <-errs:
+			return err
+		}
+	}
+}
+
+// 订阅docker容器事件 连接断开后重连
+func ContainerEventWatcher() {
+	for {
+		if err := watchContainerEvents(); err != nil {
+			log.Infof("watch container events err=%v", err)
+		}
+		globalContainerEvents.markGap()
+		time.Sleep(time.Second * 5)
+	}
+}
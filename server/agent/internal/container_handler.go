@@ -4,22 +4,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/blkiodev"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/shirou/gopsutil/mem"
 	log "github.com/sirupsen/logrus"
 
+	"scmc/common"
 	"scmc/model"
 	"scmc/rpc"
 	pb "scmc/rpc/pb/container"
@@ -50,14 +58,184 @@ func dockerResourceConfig(in *pb.ResourceLimit) container.Resources {
 			r.NanoCPUs = int64(numCPU()) * 1e9
 		}
 		r.CPUShares = toCPUShares(in.CpuPrio)
+		r.CpusetCpus = in.CpusetCpus
+		r.CpusetMems = in.CpusetMems
 		r.Memory = int64(in.MemoryLimit * megaBytes)
 		r.MemoryReservation = int64(in.MemorySoftLimit * megaBytes)
 		r.MemorySwap = int64(in.MemoryLimit * megaBytes) // prevent error: Memory limit should be smaller than already set memoryswap limit
+		if in.MemorySwap < 0 {
+			r.MemorySwap = -1
+		} else if in.MemorySwap > 0 {
+			r.MemorySwap = int64(in.MemorySwap * megaBytes)
+		}
+
+		pidsLimit := in.PidsLimit
+		if pidsLimit <= 0 {
+			pidsLimit = -1 // 更新时-1表示取消限制
+		}
+		r.PidsLimit = &pidsLimit
+
+		for _, t := range in.BlkioThrottles {
+			if t.ReadBps > 0 {
+				r.BlkioDeviceReadBps = append(r.BlkioDeviceReadBps, &blkiodev.ThrottleDevice{Path: t.Device, Rate: t.ReadBps})
+			}
+			if t.WriteBps > 0 {
+				r.BlkioDeviceWriteBps = append(r.BlkioDeviceWriteBps, &blkiodev.ThrottleDevice{Path: t.Device, Rate: t.WriteBps})
+			}
+			if t.ReadIops > 0 {
+				r.BlkioDeviceReadIOps = append(r.BlkioDeviceReadIOps, &blkiodev.ThrottleDevice{Path: t.Device, Rate: t.ReadIops})
+			}
+			if t.WriteIops > 0 {
+				r.BlkioDeviceWriteIOps = append(r.BlkioDeviceWriteIOps, &blkiodev.ThrottleDevice{Path: t.Device, Rate: t.WriteIops})
+			}
+		}
 	}
 
 	return r
 }
 
+func fromBlkioThrottles(r *container.Resources) []*pb.BlkioThrottle {
+	m := make(map[string]*pb.BlkioThrottle)
+	get := func(device string) *pb.BlkioThrottle {
+		if _, ok := m[device]; !ok {
+			m[device] = &pb.BlkioThrottle{Device: device}
+		}
+		return m[device]
+	}
+
+	for _, d := range r.BlkioDeviceReadBps {
+		get(d.Path).ReadBps = d.Rate
+	}
+	for _, d := range r.BlkioDeviceWriteBps {
+		get(d.Path).WriteBps = d.Rate
+	}
+	for _, d := range r.BlkioDeviceReadIOps {
+		get(d.Path).ReadIops = d.Rate
+	}
+	for _, d := range r.BlkioDeviceWriteIOps {
+		get(d.Path).WriteIops = d.Rate
+	}
+
+	var throttles []*pb.BlkioThrottle
+	for _, t := range m {
+		throttles = append(throttles, t)
+	}
+	sort.Slice(throttles, func(i, j int) bool { return throttles[i].Device < throttles[j].Device })
+	return throttles
+}
+
+// 除cpu/内存基本限制外的资源限制
+func setExtendedResourceLimit(rsc *pb.ResourceLimit, hostConfig *container.HostConfig) {
+	rsc.CpusetCpus = hostConfig.CpusetCpus
+	rsc.CpusetMems = hostConfig.CpusetMems
+	rsc.OomScoreAdj = int64(hostConfig.OomScoreAdj)
+	rsc.BlkioThrottles = fromBlkioThrottles(&hostConfig.Resources)
+
+	if hostConfig.MemorySwap < 0 {
+		rsc.MemorySwap = -1
+	} else if hostConfig.MemorySwap > 0 && hostConfig.MemorySwap != hostConfig.Memory {
+		rsc.MemorySwap = float64(hostConfig.MemorySwap) / megaBytes
+	}
+
+	if hostConfig.PidsLimit != nil && *hostConfig.PidsLimit > 0 {
+		rsc.PidsLimit = *hostConfig.PidsLimit
+	}
+}
+
+// docker不支持更新的资源限制 仅创建时生效
+func createOnlyResourceLimitDiff(r0, r1 *pb.ResourceLimit) bool {
+	if r0.GetOomScoreAdj() != r1.GetOomScoreAdj() || len(r0.GetBlkioThrottles()) != len(r1.GetBlkioThrottles()) {
+		return true
+	}
+
+	t1 := make(map[string]*pb.BlkioThrottle)
+	for _, t := range r1.GetBlkioThrottles() {
+		t1[t.Device] = t
+	}
+	for _, t := range r0.GetBlkioThrottles() {
+		if !proto.Equal(t, t1[t.Device]) {
+			return true
+		}
+	}
+	return false
+}
+
+func dockerPortConfig(ports []*pb.Port) (nat.PortSet, nat.PortMap, error) {
+	exposed, bindings := nat.PortSet{}, nat.PortMap{}
+	for _, p := range ports {
+		proto := p.Type
+		if proto == "" {
+			proto = "tcp"
+		}
+
+		port, err := nat.NewPort(proto, strconv.Itoa(int(p.PrivatePort)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		binding := nat.PortBinding{HostIP: p.Ip}
+		if p.PublicPort > 0 {
+			binding.HostPort = strconv.Itoa(int(p.PublicPort))
+		}
+
+		exposed[port] = struct{}{}
+		bindings[port] = append(bindings[port], binding)
+	}
+
+	return exposed, bindings, nil
+}
+
+func fromPortBindings(bindings nat.PortMap) []*pb.Port {
+	var ports []*pb.Port
+	for port, list := range bindings {
+		for _, b := range list {
+			hostPort, _ := strconv.Atoi(b.HostPort)
+			ports = append(ports, &pb.Port{
+				Ip:          b.HostIP,
+				PrivatePort: uint32(port.Int()),
+				PublicPort:  uint32(hostPort),
+				Type:        port.Proto(),
+			})
+		}
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].PrivatePort != ports[j].PrivatePort {
+			return ports[i].PrivatePort < ports[j].PrivatePort
+		}
+		return ports[i].Type < ports[j].Type
+	})
+	return ports
+}
+
+// 按解析符号链接后的主机路径直通设备, 解析后的路径同样须在节点允许列表中
+func dockerDeviceMappings(devices []*pb.DeviceMapping, allowlist []string) ([]container.DeviceMapping, error) {
+	var r []container.DeviceMapping
+	for _, d := range devices {
+		path, err := model.ResolveDevice(d.PathOnHost)
+		if err != nil {
+			log.Infof("resolve device=%v err=%v", d.PathOnHost, err)
+			return nil, status.Errorf(codes.InvalidArgument, "设备%s不存在或不是设备文件", d.PathOnHost)
+		} else if !model.IsDeviceAllowed(allowlist, d.PathOnHost) || !model.IsDeviceAllowed(allowlist, path) {
+			return nil, status.Errorf(codes.PermissionDenied, "节点不允许直通设备%s", d.PathOnHost)
+		}
+
+		m := container.DeviceMapping{
+			PathOnHost:        path,
+			PathInContainer:   d.PathInContainer,
+			CgroupPermissions: d.CgroupPermissions,
+		}
+		if m.PathInContainer == "" {
+			m.PathInContainer = d.PathOnHost
+		}
+		if m.CgroupPermissions == "" {
+			m.CgroupPermissions = "rwm"
+		}
+		r = append(r, m)
+	}
+	return r, nil
+}
+
 func ensureLocalImage(cli *client.Client, image string) error {
 	list, err := cli.ImageList(context.Background(), types.ImageListOptions{})
 	if err != nil {
@@ -121,7 +299,11 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 		log.Warnf("getContainerStats: %v", err)
 	}
 
-	opts := types.ContainerListOptions{All: in.GetListAll(), Size: true}
+	opts := types.ContainerListOptions{
+		All:     in.GetListAll() || len(in.GetStates()) > 0,
+		Size:    true,
+		Filters: containerListFilters(in),
+	}
 	containers, err := cli.ContainerList(context.Background(), opts)
 	if err != nil {
 		log.Warnf("ContainerList: %v", err)
@@ -129,37 +311,51 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 	}
 
 	for _, c := range containers {
+		// 参考docker cli实现 去掉link特性连接的其他容器名
+		var name string
+		for _, n := range c.Names {
+			if strings.HasPrefix(n, "/") && len(strings.Split(n[1:], "/")) == 1 {
+				name = n[1:]
+				break
+			}
+		}
+
+		if !matchContainer(in, name, c.Image) {
+			continue
+		}
+
 		info := pb.ContainerInfo{
 			Id:      c.ID,
+			Name:    name,
 			Image:   c.Image,
 			ImageId: c.ImageID,
 			Command: c.Command,
 			State:   c.State,
 			Created: c.Created,
+			Labels:  c.Labels,
 		}
 
-		if c.State != "created" {
-			ci, err := cli.ContainerInspect(ctx, c.ID)
-			if err != nil {
-				log.Warnf("ContainerInspect id=%v err=%v", c.ID, err)
-				// dont return error
-			} else {
-				if ci.State != nil {
-					startedAt, err := time.ParseInLocation(time.RFC3339Nano, ci.State.StartedAt, time.UTC)
-					if err != nil {
-						log.Warnf("ParseInLocation time=%v err=%v", ci.State.StartedAt, err)
-					} else {
-						info.Started = startedAt.Unix()
-					}
+		ci, err := cli.ContainerInspect(ctx, c.ID)
+		if err != nil {
+			log.Warnf("ContainerInspect id=%v err=%v", c.ID, err)
+			// dont return error
+		} else {
+			if c.State != "created" && ci.State != nil {
+				startedAt, err := time.ParseInLocation(time.RFC3339Nano, ci.State.StartedAt, time.UTC)
+				if err != nil {
+					log.Warnf("ParseInLocation time=%v err=%v", ci.State.StartedAt, err)
+				} else {
+					info.Started = startedAt.Unix()
+				}
+
+				if ci.State.Health != nil {
+					info.Health = ci.State.Health.Status
 				}
 			}
-		}
 
-		// 参考docker cli实现 去掉link特性连接的其他容器名
-		for _, name := range c.Names {
-			if strings.HasPrefix(name, "/") && len(strings.Split(name[1:], "/")) == 1 {
-				info.Name = name[1:]
-				break
+			// 返回配置的端口映射 未运行的容器同样占用主机端口
+			if ci.HostConfig != nil {
+				info.Ports = fromPortBindings(ci.HostConfig.PortBindings)
 			}
 		}
 
@@ -290,7 +486,7 @@ func (s *ContainerServer) setSecurityConfig(id, uuid, name string, pid int, from
 	return nil
 }
 
-func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
+func (s *ContainerServer) create(configs *pb.ContainerConfigs, deviceAllowlist []string) (string, error) {
 	if configs == nil || configs.Image == "" {
 		return "", rpc.ErrInvalidArgument
 	} else if !regexp.MustCompile(containerNamePattern).MatchString(configs.Name) {
@@ -323,6 +519,10 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 	}
 	var networkConfig *network.NetworkingConfig
 
+	if configs.Creator > 0 {
+		config.Labels["KS_SCMC_CREATOR"] = strconv.FormatInt(configs.Creator, 10)
+	}
+
 	for k, v := range configs.Envs {
 		config.Env = append(config.Env, fmt.Sprintf("%s=%s", k, v))
 	}
@@ -345,13 +545,32 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 		config.Labels["KS_SCMC_GRAPHIC"] = "1"
 	}
 
+	if h := configs.HealthCheck; h != nil && h.Cmd != "" {
+		config.Healthcheck = &container.HealthConfig{
+			Test:        []string{"CMD-SHELL", h.Cmd},
+			Interval:    time.Duration(h.Interval) * time.Second,
+			Timeout:     time.Duration(h.Timeout) * time.Second,
+			StartPeriod: time.Duration(h.StartPeriod) * time.Second,
+			Retries:     int(h.Retries),
+		}
+	}
+
 	if configs.RestartPolicy != nil {
 		hostConfig.RestartPolicy.Name = configs.RestartPolicy.Name
 		hostConfig.RestartPolicy.MaximumRetryCount = int(configs.RestartPolicy.MaxRetry)
 	}
 
+	if len(configs.Ports) > 0 {
+		config.ExposedPorts, hostConfig.PortBindings, err = dockerPortConfig(configs.Ports)
+		if err != nil {
+			log.Infof("dockerPortConfig ports=%v err=%v", configs.Ports, err)
+			return "", status.Errorf(codes.InvalidArgument, "端口映射参数错误")
+		}
+	}
+
 	if configs.ResouceLimit != nil {
 		hostConfig.Resources = dockerResourceConfig(configs.ResouceLimit)
+		hostConfig.OomScoreAdj = int(configs.ResouceLimit.OomScoreAdj)
 
 		if configs.ResouceLimit.DiskLimit > 0.0 {
 			config.Labels["KS_SCMC_DISK_LIMIT"] = fmt.Sprintf("%f", configs.ResouceLimit.DiskLimit)
@@ -361,6 +580,14 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 		}
 	}
 
+	if hostConfig.Devices, err = dockerDeviceMappings(configs.Devices, deviceAllowlist); err != nil {
+		return "", err
+	}
+
+	if err := containerHardeningSetup(configs.SecurityConfig, &config, &hostConfig); err != nil {
+		return "", err
+	}
+
 	var networkConfigCreate *network.NetworkingConfig
 	if len(configs.Networks) > 0 {
 		networkConfig = &network.NetworkingConfig{
@@ -394,6 +621,7 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 		log.Warnf("ContainerCreate: %v", err)
 		return "", transDockerError(err)
 	}
+	globalContainerEvents.markOperation(body.ID)
 
 	if len(configs.Networks) > 1 {
 		for i := 1; i < len(configs.Networks); i++ {
@@ -406,6 +634,10 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 		}
 	}
 
+	if err := model.SaveContainerBandwidth(body.ID, bandwidthLimits(configs.Networks)); err != nil {
+		log.Warnf("SaveContainerBandwidth container=%v err=%v", body.ID, err)
+	}
+
 	if err := s.setSecurityConfig(body.ID, configs.Uuid, configs.Name, 0, false, configs.SecurityConfig); err != nil {
 		log.Warnf("setSecurityConfig failed err=%v", err)
 		if e := s.remove(cli, body.ID, true); e != nil {
@@ -419,7 +651,7 @@ func (s *ContainerServer) create(configs *pb.ContainerConfigs) (string, error) {
 }
 
 func (s *ContainerServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
-	id, err := s.create(in.Configs)
+	id, err := s.create(in.Configs, in.DeviceAllowlist)
 	return &pb.CreateReply{ContainerId: id}, err
 }
 
@@ -436,6 +668,7 @@ func (s *ContainerServer) Start(ctx context.Context, in *pb.StartRequest) (*pb.S
 	}
 
 	opts := types.ContainerStartOptions{}
+	globalContainerEvents.markOperation(in.Ids[0].ContainerIds...)
 	for _, id := range in.Ids[0].ContainerIds {
 		if err := cli.ContainerStart(context.Background(), id, opts); err != nil {
 			log.Warnf("ContainerStart: id=%v %v", id, err)
@@ -462,6 +695,7 @@ func (s *ContainerServer) parallelProcessing(operate string, contaienrIds []stri
 		return nil, nil, rpc.ErrInternal
 	}
 
+	globalContainerEvents.markOperation(contaienrIds...)
 	timeout := time.Second
 	var wg sync.WaitGroup
 	var lock sync.Mutex
@@ -532,6 +766,7 @@ func (s *ContainerServer) Kill(ctx context.Context, in *pb.KillRequest) (*pb.Kil
 		return nil, rpc.ErrInternal
 	}
 
+	globalContainerEvents.markOperation(in.Ids[0].ContainerIds...)
 	for _, id := range in.Ids[0].ContainerIds {
 		if err := cli.ContainerKill(context.Background(), id, ""); err != nil { // TODO signal
 			log.Warnf("ContainerKill: id=%v %v", id, err)
@@ -589,6 +824,17 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 		return nil, rpc.ErrInternal
 	}
 
+	if in.ResourceLimit != nil && createOnlyResourceLimitDiff(inspectConfigs.ResouceLimit, in.ResourceLimit) {
+		log.Infof("Update container=%v create-only resource limit changed", in.ContainerId)
+		return nil, status.Errorf(codes.FailedPrecondition, "OOM评分调整和块设备IO限制仅支持创建时设置")
+	}
+
+	// docker不支持修改已创建容器的加固配置
+	if in.SecurityConfig != nil && hardeningConfigDiff(inspectConfigs.SecurityConfig, in.SecurityConfig) {
+		log.Infof("Update container=%v hardening config changed", in.ContainerId)
+		return nil, status.Errorf(codes.FailedPrecondition, "容器加固配置仅支持创建时设置")
+	}
+
 	cli, err := model.DockerClient()
 	if err != nil {
 		return nil, rpc.ErrInternal
@@ -598,6 +844,16 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 		Resources: dockerResourceConfig(in.ResourceLimit),
 	}
 
+	// docker更新时忽略空的cpuset 取消绑定需设置为全部CPU/内存节点
+	if in.ResourceLimit != nil && inspectConfigs.ResouceLimit != nil {
+		if in.ResourceLimit.CpusetCpus == "" && inspectConfigs.ResouceLimit.CpusetCpus != "" {
+			config.Resources.CpusetCpus = fmt.Sprintf("0-%d", numCPU()-1)
+		}
+		if in.ResourceLimit.CpusetMems == "" && inspectConfigs.ResouceLimit.CpusetMems != "" {
+			config.Resources.CpusetMems = onlineMemNodes()
+		}
+	}
+
 	if in.RestartPolicy != nil {
 		config.RestartPolicy = container.RestartPolicy{
 			Name:              in.RestartPolicy.Name,
@@ -605,6 +861,7 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 		}
 	}
 
+	globalContainerEvents.markOperation(in.ContainerId)
 	body, err := cli.ContainerUpdate(context.Background(), in.ContainerId, config)
 	if err != nil {
 		log.Warnf("ContainerUpdate: %v", err)
@@ -692,6 +949,17 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 		}
 	}
 
+	if in.Networks != nil {
+		if err := model.SaveContainerBandwidth(inspectConfigs.ContainerId, bandwidthLimits(in.Networks)); err != nil {
+			log.Warnf("SaveContainerBandwidth container=%v err=%v", in.ContainerId, err)
+			return nil, rpc.ErrInternal
+		}
+		if err := applyContainerBandwidth(inspectConfigs.ContainerId, true); err != nil {
+			log.Warnf("applyContainerBandwidth container=%v err=%v", in.ContainerId, err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
 	if err := s.setSecurityConfig(in.ContainerId, inspectConfigs.Uuid, inspectConfigs.Name, pid, true, in.SecurityConfig); err != nil {
 		log.Warnf("Update setSecurityConfig err=%v", err)
 		return nil, err
@@ -720,6 +988,7 @@ func (s *ContainerServer) remove(cli *client.Client, containerID string, force b
 	}
 
 	opts := types.ContainerRemoveOptions{RemoveVolumes: true, Force: force}
+	globalContainerEvents.markOperation(containerID)
 	if err := cli.ContainerRemove(context.Background(), containerID, opts); err != nil {
 		log.Warnf("ContainerRemove: id=%v %v", containerID, err)
 		return rpc.ErrInternal
@@ -743,6 +1012,10 @@ func (s *ContainerServer) remove(cli *client.Client, containerID string, force b
 	fileName := containerID + "-" + configs.Name
 	model.ContainerRemveIPtables(fileName)
 
+	if err := model.RemoveContainerBandwidth(configs.ContainerId); err != nil {
+		log.Warnf("RemoveContainerBandwidth container=%v err=%v", containerID, err)
+	}
+
 	if err := model.DelSensitiveContainers(configs.Name, containerID); err != nil {
 		log.Warnf("DelSensitiveContainers err=%v", err)
 	}
@@ -820,6 +1093,10 @@ func (s *ContainerServer) inspect(id string, backup bool) (*pb.ContainerConfigs,
 			configs.Uuid = v
 		}
 
+		if v, ok := info.Config.Labels["KS_SCMC_CREATOR"]; ok {
+			configs.Creator, _ = strconv.ParseInt(v, 10, 64)
+		}
+
 		if len(info.Config.Env) > 0 {
 			configs.Envs = make(map[string]string, len(info.Config.Env))
 			for _, e := range info.Config.Env {
@@ -830,6 +1107,16 @@ func (s *ContainerServer) inspect(id string, backup bool) (*pb.ContainerConfigs,
 			}
 		}
 
+		if h := info.Config.Healthcheck; h != nil && len(h.Test) > 1 && h.Test[0] == "CMD-SHELL" {
+			configs.HealthCheck = &pb.HealthCheck{
+				Cmd:         h.Test[1],
+				Interval:    int64(h.Interval / time.Second),
+				Timeout:     int64(h.Timeout / time.Second),
+				Retries:     int32(h.Retries),
+				StartPeriod: int64(h.StartPeriod / time.Second),
+			}
+		}
+
 		for _, m := range info.Mounts {
 			configs.Mounts = append(configs.Mounts, &pb.Mount{
 				Type:     string(m.Type),
@@ -856,9 +1143,47 @@ func (s *ContainerServer) inspect(id string, backup bool) (*pb.ContainerConfigs,
 		if s, ok := info.HostConfig.StorageOpt["size"]; ok {
 			fmt.Sscanf(s, "%fM", &configs.ResouceLimit.DiskLimit)
 		}
+		setExtendedResourceLimit(configs.ResouceLimit, info.HostConfig)
+
+		configs.Ports = fromPortBindings(info.HostConfig.PortBindings)
+
+		for _, d := range info.HostConfig.Devices {
+			configs.Devices = append(configs.Devices, &pb.DeviceMapping{
+				PathOnHost:        d.PathOnHost,
+				PathInContainer:   d.PathInContainer,
+				CgroupPermissions: d.CgroupPermissions,
+			})
+		}
+
+		sec := &pb.SecurityConfig{
+			CapAdd:         info.HostConfig.CapAdd,
+			CapDrop:        info.HostConfig.CapDrop,
+			ReadOnlyRootfs: info.HostConfig.ReadonlyRootfs,
+			Tmpfs:          info.HostConfig.Tmpfs,
+		}
+		for _, opt := range info.HostConfig.SecurityOpt {
+			if opt == noNewPrivileges || opt == noNewPrivileges+":true" {
+				sec.NoNewPrivileges = true
+			}
+		}
+		if info.Config != nil {
+			sec.SeccompProfile = info.Config.Labels["KS_SCMC_SECCOMP"]
+		}
+		if hardeningConfigDiff(sec, nil) {
+			configs.SecurityConfig = sec
+		}
 	}
 
 	if info.NetworkSettings != nil {
+		limits, err := model.LoadContainerBandwidth(info.ID)
+		if err != nil {
+			log.Infof("LoadContainerBandwidth container=%v err=%v", info.ID, err)
+		}
+		bandwidth := make(map[string]model.BandwidthLimit, len(limits))
+		for _, l := range limits {
+			bandwidth[l.Network] = l
+		}
+
 		for k, m := range info.NetworkSettings.Networks {
 			if k == "bridge" {
 				continue // "bridge" 是默认创建的网络连接, 需要忽略
@@ -882,6 +1207,8 @@ func (s *ContainerServer) inspect(id string, backup bool) (*pb.ContainerConfigs,
 				IpPrefixLen: int32(m.IPPrefixLen),
 				MacAddress:  m.MacAddress,
 				Gateway:     m.Gateway,
+				IngressRate: bandwidth[k].IngressRate,
+				EgressRate:  bandwidth[k].EgressRate,
 			})
 		}
 	}
@@ -939,6 +1266,7 @@ func (s *ContainerServer) MonitorHistory(ctx context.Context, in *pb.MonitorHist
 			if info.HostConfig.MemoryReservation > 0 {
 				rscLimit.MemorySoftLimit = float64(info.HostConfig.MemoryReservation) / megaBytes
 			}
+			setExtendedResourceLimit(&rscLimit, info.HostConfig)
 		}
 	}
 
@@ -965,15 +1293,84 @@ func (*ContainerServer) RemoveBackup(ctx context.Context, in *pb.RemoveBackupReq
 		}
 	}
 
+	if len(in.BackupName) > 0 {
+		if err := model.RemoveBackupArchives(in.BackupName); err != nil {
+			log.Warnf("remove backup=%v archives err=%v", in.BackupName, err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
 	return &pb.RemoveBackupReply{}, nil
 }
 
+func pbBackupArchives(archives []*model.BackupArchive) []*pb.BackupArchive {
+	var r []*pb.BackupArchive
+	for _, a := range archives {
+		r = append(r, &pb.BackupArchive{
+			Destination: a.Destination,
+			Type:        a.Type,
+			File:        a.File,
+			Size:        a.Size,
+			Sha256:      a.Sha256,
+		})
+	}
+	return r
+}
+
+func modelBackupArchives(archives []*pb.BackupArchive) []*model.BackupArchive {
+	var r []*model.BackupArchive
+	for _, a := range archives {
+		r = append(r, &model.BackupArchive{
+			Destination: a.Destination,
+			Type:        a.Type,
+			File:        a.File,
+			Size:        a.Size,
+			Sha256:      a.Sha256,
+		})
+	}
+	return r
+}
+
+// 恢复挂载数据失败时挂载点原有数据不变, 保留已创建的容器并返回未恢复的挂载点
+func restoreBackupArchives(containerID string, archives []*model.BackupArchive) []string {
+	restore, err := model.PrepareContainerMounts(containerID, archives)
+	if err != nil {
+		log.Warnf("prepare container=%v mounts err=%v", containerID, err)
+		return backupArchiveDestinations(archives)
+	}
+
+	if err := restore.Commit(); err != nil {
+		log.Warnf("restore container=%v mounts err=%v", containerID, err)
+		return backupArchiveDestinations(archives)
+	}
+	return nil
+}
+
+func backupArchiveDestinations(archives []*model.BackupArchive) []string {
+	var dests []string
+	for _, a := range archives {
+		dests = append(dests, a.Destination)
+	}
+	return dests
+}
+
 func (s *ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupRequest) (*pb.ResumeBackupReply, error) {
+	if in.Configs != nil {
+		return s.resumeRemoteBackup(in)
+	}
+
 	cli, err := model.DockerClient()
 	if err != nil {
 		return nil, rpc.ErrInternal
 	}
 
+	// 删除原容器前校验挂载数据归档
+	archives := modelBackupArchives(in.Archives)
+	if err := model.VerifyBackupArchives(archives); err != nil {
+		log.Warnf("verify backup archives err=%v", err)
+		return nil, status.Errorf(codes.DataLoss, "挂载数据归档校验失败")
+	}
+
 	// inspect container
 	configs, err := s.inspect(in.ContainerId, true)
 	if err != nil {
@@ -981,6 +1378,11 @@ func (s *ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupR
 		return nil, rpc.ErrInternal
 	}
 
+	// 删除原容器前检查直通设备
+	if _, err := dockerDeviceMappings(configs.Devices, in.DeviceAllowlist); err != nil {
+		return nil, err
+	}
+
 	// remove old container
 	if err := s.remove(cli, in.ContainerId, true); err != nil {
 		log.Warnf("remove container=%v err=%v", in.ContainerId, err)
@@ -990,13 +1392,81 @@ func (s *ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupR
 	// create new contaienr
 	configs.Image = in.ImageRef
 	configs.SecurityConfig = in.SecurityConfig
-	id, err := s.create(configs)
+	id, err := s.create(configs, in.DeviceAllowlist)
 	if err != nil {
 		log.Warnf("create container err=%v", err)
 		return nil, rpc.ErrInternal
 	}
+	return &pb.ResumeBackupReply{
+		ContainerId:     id,
+		SkippedArchives: restoreBackupArchives(id, archives),
+	}, nil
+}
+
+// 在其他节点上的备份 从仓库拉取备份镜像后按原配置创建容器
+func (s *ContainerServer) resumeRemoteBackup(in *pb.ResumeBackupRequest) (*pb.ResumeBackupReply, error) {
+	if in.ImageRef == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	archives := modelBackupArchives(in.Archives)
+	if err := model.VerifyBackupArchives(archives); err != nil {
+		log.Warnf("verify backup archives err=%v", err)
+		return nil, status.Errorf(codes.DataLoss, "挂载数据归档校验失败")
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	if err := ensureLocalImage(cli, in.ImageRef); err != nil {
+		if in.RegistryRef == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "备份镜像不存在")
+		}
+		if err := model.PullBackupImage(in.RegistryRef, in.ImageRef); err != nil {
+			log.Warnf("pull backup image=%v err=%v", in.RegistryRef, err)
+			return nil, status.Errorf(codes.Internal, "拉取备份镜像失败")
+		}
+	}
+
+	if _, err := dockerDeviceMappings(in.Configs.Devices, in.DeviceAllowlist); err != nil {
+		return nil, err
+	}
+
+	// 镜像及归档均就绪后再删除原容器
+	if in.ReplaceContainerId != "" {
+		if err := s.remove(cli, in.ReplaceContainerId, true); err != nil {
+			log.Warnf("remove container=%v err=%v", in.ReplaceContainerId, err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	configs := in.Configs
+	configs.Image = in.ImageRef
+	configs.SecurityConfig = in.SecurityConfig
+	id, err := s.create(configs, in.DeviceAllowlist)
+	if err != nil {
+		log.Warnf("create container err=%v", err)
+		return nil, err
+	}
+	return &pb.ResumeBackupReply{
+		ContainerId:     id,
+		SkippedArchives: restoreBackupArchives(id, archives),
+	}, nil
+}
+
+func (s *ContainerServer) PushBackup(ctx context.Context, in *pb.PushBackupRequest) (*pb.PushBackupReply, error) {
+	if in.ImageRef == "" || in.RegistryRef == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
 
-	return &pb.ResumeBackupReply{ContainerId: id}, nil
+	if err := model.PushBackupImage(in.ImageRef, in.RegistryRef); err != nil {
+		log.Warnf("push backup image=%v to %v err=%v", in.ImageRef, in.RegistryRef, err)
+		return nil, status.Errorf(codes.Internal, "推送备份镜像至仓库失败")
+	}
+
+	return &pb.PushBackupReply{}, nil
 }
 
 func (s *ContainerServer) AddBackupJob(ctx context.Context, in *pb.AddBackupJobRequest) (*pb.AddBackupJobReply, error) {
@@ -1004,7 +1474,7 @@ func (s *ContainerServer) AddBackupJob(ctx context.Context, in *pb.AddBackupJobR
 		return nil, rpc.ErrInvalidArgument
 	}
 
-	if err := model.AddContainerBackupJob(in.Id, in.ContainerId, in.BackupName); err != nil {
+	if err := model.AddContainerBackupJob(in.Id, in.ContainerId, in.BackupName, in.WithMounts); err != nil {
 		log.Infof("model.AddContainerBackupJob err=%v", err)
 		return nil, rpc.ErrInternal
 	}
@@ -1020,9 +1490,32 @@ func (s *ContainerServer) GetBackupJob(ctx context.Context, in *pb.GetBackupJobR
 	job, err := model.GetContainerBackupJob(in.Id)
 	if err != nil {
 		log.Infof("GetContainerBackupJob err=%v", err)
+		if err == model.ErrBackupJobNotFound {
+			return nil, rpc.ErrNotFound
+		}
 		return nil, rpc.ErrInternal
 	}
 
+	return backupJobReply(job), nil
+}
+
+func (s *ContainerServer) ListBackupJob(ctx context.Context, in *pb.ListBackupJobRequest) (*pb.ListBackupJobReply, error) {
+	reply := pb.ListBackupJobReply{}
+	for _, id := range in.Ids {
+		job, err := model.GetContainerBackupJob(id)
+		if err != nil {
+			if err != model.ErrBackupJobNotFound {
+				log.Infof("GetContainerBackupJob id=%v err=%v", id, err)
+			}
+			continue
+		}
+		reply.Jobs = append(reply.Jobs, backupJobReply(job))
+	}
+
+	return &reply, nil
+}
+
+func backupJobReply(job *model.ContainerBackupJob) *pb.GetBackupJobReply {
 	return &pb.GetBackupJobReply{
 		Id:          job.ID,
 		ContainerId: job.ContainerID,
@@ -1032,7 +1525,59 @@ func (s *ContainerServer) GetBackupJob(ctx context.Context, in *pb.GetBackupJobR
 		ImageSize:   job.ImageSize,
 		Status:      job.Status,
 		UpdatedAt:   job.UpdatedAt,
-	}, nil
+		Archives:    pbBackupArchives(job.Archives),
+		Progress:    job.Progress,
+		Total:       job.Total,
+		Error:       job.Error,
+	}
+}
+
+func (s *ContainerServer) CancelBackupJob(ctx context.Context, in *pb.CancelBackupJobRequest) (*pb.CancelBackupJobReply, error) {
+	if in.Id <= 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	if err := model.CancelContainerBackupJob(in.Id); err != nil {
+		log.Infof("CancelContainerBackupJob id=%v err=%v", in.Id, err)
+		switch err {
+		case model.ErrBackupJobNotFound:
+			return nil, rpc.ErrNotFound
+		case model.ErrBackupJobDone:
+			return nil, status.Errorf(codes.FailedPrecondition, "备份任务已结束")
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.CancelBackupJobReply{}, nil
+}
+
+// 先推送已结束的任务, 之后推送新结束的任务
+func (s *ContainerServer) WatchBackupJob(in *pb.WatchBackupJobRequest, stream pb.Container_WatchBackupJobServer) error {
+	ch, stop := model.WatchContainerBackupJob()
+	defer stop()
+
+	for _, job := range model.ListFinishedBackupJob() {
+		if err := stream.Send(backupJobReply(job)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case job := <-ch:
+			if err := stream.Send(backupJobReply(job)); err != nil {
+				log.Infof("WatchBackupJob send id=%v err=%v", job.ID, err)
+				return err
+			}
+		}
+	}
+}
+
+// 启动备份任务队列
+func BackupJobWorker() {
+	model.StartBackupWorkers(common.Config.Agent.BackupConcurrency)
 }
 
 func (s *ContainerServer) DelBackupJob(ctx context.Context, in *pb.DelBackupJobRequest) (*pb.DelBackupJobReply, error) {
@@ -1043,3 +1588,320 @@ func (s *ContainerServer) DelBackupJob(ctx context.Context, in *pb.DelBackupJobR
 	model.DelContainerBackupJob(in.Id)
 	return &pb.DelBackupJobReply{}, nil
 }
+
+func (s *ContainerServer) CommitImage(ctx context.Context, in *pb.CommitImageRequest) (*pb.CommitImageReply, error) {
+	if in.ContainerId == "" || in.Tag == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	configs, err := s.inspect(in.ContainerId, true)
+	if err != nil {
+		log.Warnf("inspect container=%v err=%v", in.ContainerId, err)
+		return nil, err
+	}
+
+	imageRef, imageID, imageSize, err := model.CommitContainer(in.ContainerId, in.Tag)
+	if err != nil {
+		log.Infof("model.CommitContainer err=%v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	if err := model.PushLocalImage(imageRef); err != nil {
+		log.Warnf("push image=%v err=%v", imageRef, err)
+		if cli, e := model.DockerClient(); e == nil {
+			if _, e := cli.ImageRemove(context.Background(), imageRef, types.ImageRemoveOptions{}); e != nil {
+				log.Warnf("remove image=%v err=%v", imageRef, e)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "推送镜像至仓库失败")
+	}
+
+	return &pb.CommitImageReply{
+		ImageRef:  imageRef,
+		Configs:   configs,
+		ImageId:   imageID,
+		ImageSize: imageSize,
+	}, nil
+}
+
+func (s *ContainerServer) Migrate(ctx context.Context, in *pb.MigrateRequest) (*pb.MigrateReply, error) {
+	if in.Configs == nil || in.Configs.Image == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	if err := ensureImage(cli, in.Configs.Image); err != nil {
+		log.Warnf("ensureImage image=%v err=%v", in.Configs.Image, err)
+		return nil, status.Errorf(codes.Internal, "拉取迁移镜像失败")
+	}
+
+	id, err := s.create(in.Configs, in.DeviceAllowlist)
+	if err != nil {
+		log.Warnf("create container err=%v", err)
+		return nil, err
+	}
+
+	return &pb.MigrateReply{ContainerId: id}, nil
+}
+
+// streamWriter 将容器输出转发至grpc stream
+type streamWriter func(p []byte) error
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *ContainerServer) Exec(stream pb.Container_ExecServer) error {
+	in, err := stream.Recv()
+	if err != nil {
+		log.Infof("Exec receive first request err=%v", err)
+		return rpc.ErrInvalidArgument
+	}
+
+	if in.ContainerId == "" {
+		return rpc.ErrInvalidArgument
+	}
+
+	cmd := in.Cmd
+	if len(cmd) == 0 {
+		cmd = []string{"/bin/sh"}
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	execResp, err := cli.ContainerExecCreate(ctx, in.ContainerId, types.ExecConfig{
+		Tty:          in.Tty,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		log.Warnf("ContainerExecCreate id=%v cmd=%v err=%v", in.ContainerId, cmd, err)
+		return transDockerError(err)
+	}
+
+	hijacked, err := cli.ContainerExecAttach(ctx, execResp.ID, types.ExecStartCheck{Tty: in.Tty})
+	if err != nil {
+		log.Warnf("ContainerExecAttach id=%v err=%v", execResp.ID, err)
+		return transDockerError(err)
+	}
+	defer hijacked.Close()
+
+	resize := func(size *pb.TerminalSize) {
+		if size == nil || !in.Tty {
+			return
+		}
+		if err := cli.ContainerExecResize(ctx, execResp.ID, types.ResizeOptions{
+			Height: uint(size.Height),
+			Width:  uint(size.Width),
+		}); err != nil {
+			log.Infof("ContainerExecResize id=%v err=%v", execResp.ID, err)
+		}
+	}
+	resize(in.Resize)
+
+	// 转发终端输入
+	go func() {
+		defer hijacked.CloseWrite()
+		for {
+			r, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					log.Infof("Exec receive id=%v err=%v", execResp.ID, err)
+				}
+				return
+			}
+
+			if len(r.Stdin) > 0 {
+				if _, err := hijacked.Conn.Write(r.Stdin); err != nil {
+					log.Infof("Exec write stdin id=%v err=%v", execResp.ID, err)
+					return
+				}
+			}
+			resize(r.Resize)
+		}
+	}()
+
+	// 转发终端输出 非tty模式下需要区分stdout/stderr
+	stdout := streamWriter(func(p []byte) error { return stream.Send(&pb.ExecReply{Stdout: p}) })
+	stderr := streamWriter(func(p []byte) error { return stream.Send(&pb.ExecReply{Stderr: p}) })
+	if in.Tty {
+		_, err = io.Copy(stdout, hijacked.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, hijacked.Reader)
+	}
+	if err != nil {
+		log.Infof("Exec copy output id=%v err=%v", execResp.ID, err)
+		return rpc.ErrInternal
+	}
+
+	info, err := cli.ContainerExecInspect(ctx, execResp.ID)
+	if err != nil {
+		log.Warnf("ContainerExecInspect id=%v err=%v", execResp.ID, err)
+		return transDockerError(err)
+	}
+
+	return stream.Send(&pb.ExecReply{Exited: true, ExitCode: int32(info.ExitCode)})
+}
+
+func (s *ContainerServer) Logs(in *pb.LogsRequest, stream pb.Container_LogsServer) error {
+	if in.ContainerId == "" || in.Tail < 0 {
+		return rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	info, err := cli.ContainerInspect(context.Background(), in.ContainerId)
+	if err != nil {
+		log.Infof("ContainerInspect: %v", err)
+		return transDockerError(err)
+	}
+
+	opts := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     in.Follow,
+		Timestamps: in.Timestamps,
+		Tail:       "all",
+	}
+	if in.Tail > 0 {
+		opts.Tail = strconv.FormatInt(in.Tail, 10)
+	}
+	if in.Since > 0 {
+		opts.Since = strconv.FormatInt(in.Since, 10)
+	}
+	if in.Until > 0 {
+		opts.Until = strconv.FormatInt(in.Until, 10)
+	}
+
+	reader, err := cli.ContainerLogs(stream.Context(), in.ContainerId, opts)
+	if err != nil {
+		log.Warnf("ContainerLogs id=%v err=%v", in.ContainerId, err)
+		return transDockerError(err)
+	}
+	defer reader.Close()
+
+	// tty模式的容器日志未做多路复用
+	stdout := streamWriter(func(p []byte) error { return stream.Send(&pb.LogsReply{Stdout: p}) })
+	stderr := streamWriter(func(p []byte) error { return stream.Send(&pb.LogsReply{Stderr: p}) })
+	if info.Config != nil && info.Config.Tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	}
+	if err != nil && stream.Context().Err() == nil {
+		log.Infof("Logs copy output id=%v err=%v", in.ContainerId, err)
+		return rpc.ErrInternal
+	}
+
+	return nil
+}
+
+func (s *ContainerServer) Events(ctx context.Context, in *pb.EventsRequest) (*pb.EventsReply, error) {
+	return globalContainerEvents.list(in.Epoch, in.SinceId), nil
+}
+
+func (s *ContainerServer) Diff(ctx context.Context, in *pb.DiffRequest) (*pb.DiffReply, error) {
+	if in.ContainerId == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	changes, err := cli.ContainerDiff(context.Background(), in.ContainerId)
+	if err != nil {
+		log.Warnf("ContainerDiff container=%v err=%v", in.ContainerId, err)
+		return nil, transDockerError(err)
+	}
+
+	reply := pb.DiffReply{}
+	for _, c := range changes {
+		reply.Changes = append(reply.Changes, &pb.FileChange{
+			Path: c.Path,
+			Kind: pb.FILE_CHANGE_KIND(c.Kind),
+		})
+	}
+	return &reply, nil
+}
+
+func (s *ContainerServer) Export(in *pb.ExportRequest, stream pb.Container_ExportServer) error {
+	if in.ContainerId == "" {
+		return rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	configs, err := s.inspect(in.ContainerId, true)
+	if err != nil {
+		log.Warnf("inspect container=%v err=%v", in.ContainerId, err)
+		return err
+	}
+
+	info, err := cli.ContainerInspect(context.Background(), in.ContainerId)
+	if err != nil {
+		log.Warnf("ContainerInspect: %v", err)
+		return transDockerError(err)
+	}
+
+	imageConfig := &pb.ImageConfig{}
+	if info.Config != nil {
+		imageConfig.Cmd = info.Config.Cmd
+		imageConfig.Entrypoint = info.Config.Entrypoint
+		imageConfig.Env = info.Config.Env
+		imageConfig.WorkingDir = info.Config.WorkingDir
+		imageConfig.User = info.Config.User
+	}
+
+	reader, err := cli.ContainerExport(stream.Context(), in.ContainerId)
+	if err != nil {
+		log.Warnf("ContainerExport id=%v err=%v", in.ContainerId, err)
+		return transDockerError(err)
+	}
+	defer reader.Close()
+
+	if err := stream.Send(&pb.ExportReply{Info: &pb.ExportInfo{Configs: configs, ImageConfig: imageConfig}}); err != nil {
+		log.Infof("Export send info err=%v", err)
+		return err
+	}
+
+	buffer := make([]byte, 1024*1024)
+	for {
+		n, err := io.ReadFull(reader, buffer)
+		if n > 0 {
+			if err := stream.Send(&pb.ExportReply{ChunkData: buffer[:n]}); err != nil {
+				log.Infof("Export send chunk err=%v", err)
+				return err
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			log.Warnf("Export read container=%v err=%v", in.ContainerId, err)
+			return rpc.ErrInternal
+		}
+	}
+}
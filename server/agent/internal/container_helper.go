@@ -1,14 +1,24 @@
 package internal
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
+	"github.com/golang/protobuf/proto"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"scmc/common"
+	pb "scmc/rpc/pb/container"
 )
 
 const (
@@ -16,6 +26,8 @@ const (
 	containerAuthPath    = "/tmp/.xauth"
 	authFile             = "Xauthority"
 	containerNamePattern = `^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`
+	seccompNamePattern   = `^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`
+	noNewPrivileges      = "no-new-privileges"
 )
 
 func containerGraphicSetup(containerName string, config *container.Config, hostConfig *container.HostConfig) error {
@@ -63,3 +75,98 @@ func removeContainerGraphicSetup(containerName string) error {
 	hostPath := filepath.Join(common.Config.Agent.ContainerExtraDataBasedir, containerName)
 	return os.RemoveAll(hostPath)
 }
+
+func seccompProfilePath(name string) string {
+	return filepath.Join(common.Config.Agent.SeccompProfileDir, name+".json")
+}
+
+// 容器加固配置: capabilities、seccomp、no-new-privileges、只读根文件系统
+func containerHardeningSetup(sec *pb.SecurityConfig, config *container.Config, hostConfig *container.HostConfig) error {
+	if sec == nil {
+		return nil
+	}
+
+	hostConfig.CapAdd = sec.CapAdd
+	hostConfig.CapDrop = sec.CapDrop
+	hostConfig.ReadonlyRootfs = sec.ReadOnlyRootfs
+	if len(sec.Tmpfs) > 0 {
+		hostConfig.Tmpfs = sec.Tmpfs
+	}
+
+	if sec.NoNewPrivileges {
+		hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, noNewPrivileges)
+	}
+
+	if sec.SeccompProfile != "" {
+		data, err := ioutil.ReadFile(seccompProfilePath(sec.SeccompProfile))
+		if err != nil {
+			log.Warnf("read seccomp profile=%v err=%v", sec.SeccompProfile, err)
+			return status.Errorf(codes.NotFound, "seccomp配置不存在")
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			log.Warnf("compact seccomp profile=%v err=%v", sec.SeccompProfile, err)
+			return status.Errorf(codes.InvalidArgument, "seccomp配置格式错误")
+		}
+		hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, "seccomp="+buf.String())
+		config.Labels["KS_SCMC_SECCOMP"] = sec.SeccompProfile
+	}
+	return nil
+}
+
+// 仅创建时生效的加固配置
+func hardeningConfig(sec *pb.SecurityConfig) *pb.SecurityConfig {
+	if sec == nil {
+		return &pb.SecurityConfig{}
+	}
+
+	return &pb.SecurityConfig{
+		NoNewPrivileges: sec.NoNewPrivileges,
+		ReadOnlyRootfs:  sec.ReadOnlyRootfs,
+		SeccompProfile:  sec.SeccompProfile,
+		CapAdd:          sec.CapAdd,
+		CapDrop:         sec.CapDrop,
+		Tmpfs:           sec.Tmpfs,
+	}
+}
+
+func hardeningConfigDiff(s0, s1 *pb.SecurityConfig) bool {
+	return !proto.Equal(hardeningConfig(s0), hardeningConfig(s1))
+}
+
+// 容器列表过滤条件 状态/label/uuid交由docker过滤
+func containerListFilters(in *pb.ListRequest) filters.Args {
+	args := filters.NewArgs()
+	for _, state := range in.States {
+		args.Add("status", state)
+	}
+	for k, v := range in.Labels {
+		if v == "" {
+			args.Add("label", k)
+		} else {
+			args.Add("label", k+"="+v)
+		}
+	}
+	if in.Uuid != "" {
+		args.Add("label", "KS_SCMC_UUID="+in.Uuid)
+	}
+	return args
+}
+
+// 容器名和镜像过滤
+func matchContainer(in *pb.ListRequest, name, image string) bool {
+	if in.NamePattern != "" {
+		if ok, _ := path.Match(in.NamePattern, name); !ok {
+			return false
+		}
+	}
+
+	if in.Image != "" && image != in.Image {
+		// 未指定tag时匹配该镜像的所有tag
+		if strings.Contains(in.Image[strings.LastIndex(in.Image, "/")+1:], ":") || !strings.HasPrefix(image, in.Image+":") {
+			return false
+		}
+	}
+	return true
+}
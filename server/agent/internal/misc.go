@@ -2,7 +2,9 @@
 package internal
 
 import (
+	"io/ioutil"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -81,3 +83,12 @@ func numCPU() int {
 	}
 	return c
 }
+
+// 主机在线的NUMA内存节点 如"0-1"
+func onlineMemNodes() string {
+	data, err := ioutil.ReadFile("/sys/devices/system/node/online")
+	if err != nil {
+		return "0"
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"scmc/common"
 	"scmc/model"
+	pb "scmc/rpc/pb/container"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
@@ -197,6 +198,9 @@ func ContainerWhiteCongig() error {
 					log.Infof("%v-%v: pid(%v)", e.ID, name, info.State.Pid)
 					fileName := e.ID + "-" + name
 					model.ContainerWhitelistInitialization(fileName, info.State.Pid)
+					if err := applyContainerBandwidth(e.ID, false); err != nil {
+						log.Warnf("applyContainerBandwidth container=%v err=%v", e.ID, err)
+					}
 				}
 			}
 			/*
@@ -228,6 +232,59 @@ func ContainerWhiteCongig() error {
 	return nil
 }
 
+func bandwidthLimits(networks []*pb.NetworkConfig) []model.BandwidthLimit {
+	var limits []model.BandwidthLimit
+	for _, n := range networks {
+		if n.IngressRate > 0 || n.EgressRate > 0 {
+			limits = append(limits, model.BandwidthLimit{
+				Network:     n.Interface,
+				IngressRate: n.IngressRate,
+				EgressRate:  n.EgressRate,
+			})
+		}
+	}
+	return limits
+}
+
+// 按保存的配置限制容器各网卡带宽 容器每次启动后网络命名空间重建, 需重新设置
+func applyContainerBandwidth(containerID string, fromUpdate bool) error {
+	limits, err := model.LoadContainerBandwidth(containerID)
+	if err != nil {
+		return err
+	} else if len(limits) == 0 && !fromUpdate {
+		return nil
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return err
+	}
+
+	info, err := cli.ContainerInspect(context.Background(), containerID)
+	if err != nil {
+		return err
+	} else if info.State == nil || info.State.Pid == 0 || info.NetworkSettings == nil {
+		return nil // 容器未运行 启动时设置
+	}
+
+	m := make(map[string]model.BandwidthLimit, len(limits))
+	for _, l := range limits {
+		m[l.Network] = l
+	}
+
+	for name, n := range info.NetworkSettings.Networks {
+		if n.MacAddress == "" {
+			continue
+		}
+		l := m[name]
+		if err := model.SetContainerBandwidth(info.State.Pid, n.MacAddress, l.IngressRate, l.EgressRate); err != nil {
+			log.Warnf("SetContainerBandwidth container=%v network=%v err=%v", containerID, name, err)
+			return err
+		}
+	}
+	return nil
+}
+
 func getLinkIfs() []string {
 	var linkIfs []string
 	cli, err := model.DockerClient()
@@ -2,7 +2,11 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
 	"github.com/shirou/gopsutil/disk"
 	"github.com/shirou/gopsutil/mem"
 	log "github.com/sirupsen/logrus"
@@ -16,6 +20,77 @@ type NodeServer struct {
 	pb.UnimplementedNodeServer
 }
 
+// 各容器的资源限制缓存, 容器更新资源限制后由事件清除
+var containerResources = struct {
+	sync.Mutex
+	m map[string]*pb.ResourceLimit
+}{m: make(map[string]*pb.ResourceLimit)}
+
+func forgetContainerResource(id string) {
+	containerResources.Lock()
+	delete(containerResources.m, id)
+	containerResources.Unlock()
+}
+
+func inspectContainerResource(cli *client.Client, id string) (*pb.ResourceLimit, error) {
+	info, err := cli.ContainerInspect(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var r pb.ResourceLimit
+	if info.HostConfig == nil {
+		return &r, nil
+	}
+
+	r.CpuLimit = float64(info.HostConfig.NanoCPUs) / 1e9
+	r.MemoryLimit = float64(info.HostConfig.Memory) / megaBytes
+	if size, ok := info.HostConfig.StorageOpt["size"]; ok {
+		fmt.Sscanf(size, "%fM", &r.DiskLimit)
+	}
+	return &r, nil
+}
+
+// 节点上所有容器已分配的资源限制总和, 未设置限制的容器不计入
+// 只查询缓存中没有的容器
+func allocatedResource(cli *client.Client) (*pb.ResourceLimit, error) {
+	list, err := cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	containerResources.Lock()
+	cached := make(map[string]*pb.ResourceLimit, len(list))
+	for _, c := range list {
+		if v, ok := containerResources.m[c.ID]; ok {
+			cached[c.ID] = v
+		}
+	}
+	// 已删除的容器不再保留
+	containerResources.m = cached
+	containerResources.Unlock()
+
+	var r pb.ResourceLimit
+	for _, c := range list {
+		v, ok := cached[c.ID]
+		if !ok {
+			if v, err = inspectContainerResource(cli, c.ID); err != nil {
+				log.Infof("ContainerInspect id=%v err=%v", c.ID, err)
+				continue
+			}
+			containerResources.Lock()
+			containerResources.m[c.ID] = v
+			containerResources.Unlock()
+		}
+
+		r.CpuLimit += v.CpuLimit
+		r.MemoryLimit += v.MemoryLimit
+		r.DiskLimit += v.DiskLimit
+	}
+
+	return &r, nil
+}
+
 func nodeStatus() (*pb.NodeStatus, error) {
 	cli, err := model.DockerClient()
 	if err != nil {
@@ -66,6 +141,12 @@ func nodeStatus() (*pb.NodeStatus, error) {
 		}
 	}
 
+	if allocated, err := allocatedResource(cli); err != nil {
+		log.Infof("allocatedResource err=%v", err)
+	} else {
+		ret.Allocated = allocated
+	}
+
 	return &ret, nil
 }
 
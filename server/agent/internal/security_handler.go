@@ -5,8 +5,12 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -206,3 +210,36 @@ func (sp *SecurityServer) UpdateProcProtection(ctx context.Context, in *pb.Updat
 
 	return &pb.UpdateProcProtectionReply{}, nil
 }
+
+func (s *SecurityServer) SyncSeccompProfile(ctx context.Context, in *pb.SyncSeccompProfileRequest) (*pb.SyncSeccompProfileReply, error) {
+	dir := common.Config.Agent.SeccompProfileDir
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Warnf("mkdir %v: %v", dir, err)
+		return nil, rpc.ErrInternal
+	}
+
+	for _, p := range in.Profiles {
+		if !regexp.MustCompile(seccompNamePattern).MatchString(p.Name) || !json.Valid([]byte(p.Content)) {
+			log.Infof("SyncSeccompProfile invalid profile=%v", p.Name)
+			return nil, rpc.ErrInvalidArgument
+		}
+
+		if err := ioutil.WriteFile(seccompProfilePath(p.Name), []byte(p.Content), 0644); err != nil {
+			log.Warnf("write seccomp profile=%v err=%v", p.Name, err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	for _, name := range in.Removed {
+		if !regexp.MustCompile(seccompNamePattern).MatchString(name) {
+			return nil, rpc.ErrInvalidArgument
+		}
+
+		if err := os.Remove(seccompProfilePath(name)); err != nil && !os.IsNotExist(err) {
+			log.Warnf("remove seccomp profile=%v err=%v", name, err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	return &pb.SyncSeccompProfileReply{}, nil
+}
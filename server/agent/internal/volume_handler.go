@@ -0,0 +1,209 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	volumetypes "github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/volume"
+)
+
+type VolumeServer struct {
+	pb.UnimplementedVolumeServer
+}
+
+// 数据卷占用空间 docker仅在system df中统计
+func volumeSizes(cli *client.Client) map[string]int64 {
+	du, err := cli.DiskUsage(context.Background())
+	if err != nil {
+		log.Infof("DiskUsage err=%v", err)
+		return nil
+	}
+
+	r := make(map[string]int64, len(du.Volumes))
+	for _, v := range du.Volumes {
+		if v.UsageData != nil {
+			r[v.Name] = v.UsageData.Size
+		}
+	}
+	return r
+}
+
+// 数据卷与使用该数据卷的容器
+func volumeContainers(cli *client.Client) (map[string][]*pb.VolumeContainer, error) {
+	list, err := cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string][]*pb.VolumeContainer)
+	for _, c := range list {
+		var name string
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+
+		for _, m := range c.Mounts {
+			if m.Type != mount.TypeVolume {
+				continue
+			}
+			r[m.Name] = append(r[m.Name], &pb.VolumeContainer{
+				Id:          c.ID,
+				Name:        name,
+				Destination: m.Destination,
+				ReadOnly:    !m.RW,
+			})
+		}
+	}
+	return r, nil
+}
+
+func toVolumeInfo(v *types.Volume, sizes map[string]int64, containers map[string][]*pb.VolumeContainer) *pb.VolumeInfo {
+	info := &pb.VolumeInfo{
+		Name:       v.Name,
+		Driver:     v.Driver,
+		Mountpoint: v.Mountpoint,
+		Scope:      v.Scope,
+		Labels:     v.Labels,
+		Options:    v.Options,
+		Size:       -1,
+		Containers: containers[v.Name],
+	}
+
+	if t, err := time.Parse(time.RFC3339, v.CreatedAt); err == nil {
+		info.Created = t.Unix()
+	}
+	if size, ok := sizes[v.Name]; ok {
+		info.Size = size
+	}
+	return info
+}
+
+func (s *VolumeServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListReply, error) {
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	body, err := cli.VolumeList(context.Background(), filters.NewArgs())
+	if err != nil {
+		log.Warnf("VolumeList: %v", err)
+		return nil, transDockerError(err)
+	}
+
+	containers, err := volumeContainers(cli)
+	if err != nil {
+		log.Warnf("volumeContainers: %v", err)
+		return nil, transDockerError(err)
+	}
+
+	sizes := volumeSizes(cli)
+	reply := pb.ListReply{}
+	for _, v := range body.Volumes {
+		reply.Volumes = append(reply.Volumes, &pb.NodeVolume{Info: toVolumeInfo(v, sizes, containers)})
+	}
+	return &reply, nil
+}
+
+func (s *VolumeServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	v, err := cli.VolumeCreate(context.Background(), volumetypes.VolumeCreateBody{
+		Name:       in.Name,
+		Driver:     in.Driver,
+		DriverOpts: in.DriverOpts,
+		Labels:     in.Labels,
+	})
+	if err != nil {
+		log.Warnf("VolumeCreate name=%v err=%v", in.Name, err)
+		return nil, transDockerError(err)
+	}
+
+	return &pb.CreateReply{Volume: toVolumeInfo(&v, nil, nil)}, nil
+}
+
+func (s *VolumeServer) Inspect(ctx context.Context, in *pb.InspectRequest) (*pb.InspectReply, error) {
+	if in.Name == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	v, err := cli.VolumeInspect(context.Background(), in.Name)
+	if err != nil {
+		log.Warnf("VolumeInspect name=%v err=%v", in.Name, err)
+		return nil, transDockerError(err)
+	}
+
+	containers, err := volumeContainers(cli)
+	if err != nil {
+		log.Warnf("volumeContainers: %v", err)
+		return nil, transDockerError(err)
+	}
+
+	return &pb.InspectReply{Volume: toVolumeInfo(&v, volumeSizes(cli), containers)}, nil
+}
+
+func (s *VolumeServer) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb.RemoveReply, error) {
+	if len(in.Names) == 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	reply := pb.RemoveReply{}
+	for _, name := range in.Names {
+		if err := cli.VolumeRemove(context.Background(), name, in.Force); err != nil {
+			log.Warnf("VolumeRemove name=%v err=%v", name, err)
+			var failReason string
+			if s, _ := status.FromError(transDockerError(err)); s != nil {
+				failReason = s.Message()
+			}
+			reply.FailInfos = append(reply.FailInfos, &pb.VolumeFailInfo{
+				Name:       name,
+				FailReason: failReason,
+			})
+			continue
+		}
+		reply.OkNames = append(reply.OkNames, name)
+	}
+
+	return &reply, nil
+}
+
+func (s *VolumeServer) Prune(ctx context.Context, in *pb.PruneRequest) (*pb.PruneReply, error) {
+	cli, err := model.DockerClient()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	report, err := cli.VolumesPrune(context.Background(), filters.NewArgs())
+	if err != nil {
+		log.Warnf("VolumesPrune: %v", err)
+		return nil, transDockerError(err)
+	}
+
+	return &pb.PruneReply{
+		Deleted:        report.VolumesDeleted,
+		SpaceReclaimed: report.SpaceReclaimed,
+	}, nil
+}
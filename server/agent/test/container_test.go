@@ -67,3 +67,19 @@ func TestMonitorHistory(t *testing.T) {
 		}
 	})
 }
+
+func TestContainerEvents(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		reply, err := cli.Events(ctx, &pb.EventsRequest{})
+		if err != nil {
+			t.Errorf("Events: %v", err)
+			return
+		}
+
+		for _, e := range reply.Events {
+			t.Logf("container event: %+v", e)
+		}
+		t.Logf("last event id=%v", reply.LastId)
+	})
+}
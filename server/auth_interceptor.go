@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"scmc/common"
@@ -78,10 +79,12 @@ func (auth *AuthInterceptor) Streams() grpc.StreamServerInterceptor {
 			}
 
 			if common.NeedCheckPerm() {
-				if _, err = auth.checkAuthorization(context.Background(), userID, info.FullMethod); err != nil {
-					// log.Infof("AuthInterceptor error: %v", err)
+				ctx, err := auth.checkAuthorization(ss.Context(), userID, info.FullMethod)
+				if err != nil {
+					log.Infof("AuthInterceptor method=%v user=%v error=%v", info.FullMethod, userID, err)
 					return err
 				}
+				ss = &authStream{ServerStream: ss, ctx: ctx}
 			}
 		}
 
@@ -90,6 +93,16 @@ func (auth *AuthInterceptor) Streams() grpc.StreamServerInterceptor {
 	}
 }
 
+// authStream 携带权限信息的stream, 供handler内部做细粒度权限检查
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
 func (auth *AuthInterceptor) checkAuthentication(userID, accessToken string) error {
 
 	ok, err := model.CheckUserSession(userID, accessToken)
@@ -126,7 +139,14 @@ func (auth *AuthInterceptor) checkAuthorization(ctx context.Context, userID, met
 	if !HasPerm(permID, perms) {
 		return nil, rpc.ErrPermissionDenied
 	}
-	return context.WithValue(ctx, "PERMS", perms), nil
+
+	ctx = context.WithValue(ctx, "PERMS", perms)
+	if userRole.OwnedContainersOnly {
+		// 角色限制只能访问自己的容器 记录用户ID供handler过滤
+		ownerID, _ := strconv.ParseInt(userID, 10, 64)
+		ctx = context.WithValue(ctx, "CONTAINER_OWNER", ownerID)
+	}
+	return ctx, nil
 }
 
 func getAuthInfo(ctx context.Context) (userID, accessToken string, err error) {
@@ -169,12 +189,22 @@ func getPermID(method string) pb.PERMISSION {
 		"/container.Container/Inspect",
 		"/container.Container/Status",
 		"/container.Container/ListBackup",
+		"/container.Container/ListBackupSchedule",
 		"/container.Container/GetBackupJob",
+		"/container.Container/ListBackupJob",
 		"/container.Container/MonitorHistory",
+		"/container.Container/Logs",
+		"/container.Container/Events",
+		"/container.Container/Diff",
+		"/container.Container/Export",
+		"/container.Container/DownloadBackup",
+		"/volume.Volume/List",
+		"/volume.Volume/Inspect",
 		"/network.Network/List",
 		"/network.Network/ListIPtables",
 		"/security.Security/ListProcProtection",
-		"/security.Security/ListFileProtection":
+		"/security.Security/ListFileProtection",
+		"/security.Security/ListSeccompProfile":
 		return pb.PERMISSION_CONTAINER_INFO_READ
 	case "/container.Container/Create",
 		"/container.Container/Start",
@@ -186,8 +216,24 @@ func getPermID(method string) pb.PERMISSION {
 		"/container.Container/UpdateBackup",
 		"/container.Container/ResumeBackup",
 		"/container.Container/RemoveBackup",
+		"/container.Container/CancelBackupJob",
 		"/container.Container/AddBackupJob",
 		"/container.Container/DelBackupJob",
+		"/container.Container/PushBackup",
+		"/container.Container/CreateBackupSchedule",
+		"/container.Container/UpdateBackupSchedule",
+		"/container.Container/RemoveBackupSchedule",
+		"/container.Container/Migrate",
+		"/container.Container/CommitImage",
+		"/container.Container/Share",
+		"/container.Container/CreateFromTemplate",
+		"/container.Container/Import",
+		"/container.Container/UploadBackup",
+		"/container.Container/SaveBackup",
+		"/container.Container/LoadBackup",
+		"/volume.Volume/Create",
+		"/volume.Volume/Remove",
+		"/volume.Volume/Prune",
 		"/network.Network/Connect",
 		"/network.Network/Disconnect",
 		"/network.Network/EnableIPtables",
@@ -201,12 +247,24 @@ func getPermID(method string) pb.PERMISSION {
 		return pb.PERMISSION_CONTAINER_INFO_WRITE
 	case "/container.Container/Update":
 		return pb.PERMISSION_CONTAINER_CONF_WRITE
+	case "/security.Security/CreateSeccompProfile",
+		"/security.Security/UpdateSeccompProfile",
+		"/security.Security/RemoveSeccompProfile":
+		return pb.PERMISSION_CONTAINER_CONF_SEC
+	case "/container.Container/Exec":
+		return pb.PERMISSION_CONTAINER_EXEC
 	case "/container.Container/ListTemplate",
-		"/container.Container/InspectTemplate":
+		"/container.Container/InspectTemplate",
+		"/container.Container/ListTemplateRevision",
+		"/container.Container/DiffTemplate",
+		"/container.Container/ExportTemplate",
+		"/container.Container/ValidateTemplate":
 		return pb.PERMISSION_CONTAINER_TEMP_READ
 	case "/container.Container/CreateTemplate",
 		"/container.Container/UpdateTemplate",
-		"/container.Container/RemoveTemplate":
+		"/container.Container/RemoveTemplate",
+		"/container.Container/RollbackTemplate",
+		"/container.Container/ImportTemplate":
 		return pb.PERMISSION_CONTAINER_TEMP_WRITE
 	case "/node.Node/List",
 		"/node.Node/Status":
@@ -223,6 +281,8 @@ func getPermID(method string) pb.PERMISSION {
 	case "/image.Image/Remove",
 		"/image.Image/Update",
 		"/image.Image/Upload",
+		"/image.Image/CreateUpload",
+		"/image.Image/UploadStatus",
 		"/image.Image/Download":
 		return pb.PERMISSION_IMAGE_INFO_WRITE
 	case "/image.Image/Approve":
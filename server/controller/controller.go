@@ -16,6 +16,7 @@ import (
 	"scmc/rpc/pb/node"
 	"scmc/rpc/pb/security"
 	"scmc/rpc/pb/user"
+	"scmc/rpc/pb/volume"
 	"scmc/server"
 	"scmc/server/controller/internal"
 )
@@ -55,13 +56,23 @@ func Server() (*grpc.Server, error) {
 	user.RegisterUserServer(s, &internal.UserServer{})
 	logging.RegisterLoggingServer(s, &internal.LoggingServer{})
 	security.RegisterSecurityServer(s, &internal.SecurityServer{})
+	volume.RegisterVolumeServer(s, &internal.VolumeServer{})
 
 	go internal.NodeStatusMonitor()
 	go internal.RuntimeLogWriter()
 	go model.PushImgae()
 	go internal.ResumeContainerConfigs()
 	go internal.CheckContainerBackupJob()
+	go internal.WatchBackupJobs()
+	go internal.RunBackupSchedules()
+	go internal.PushContainerBackups()
 	go internal.DetectIllegalContainer()
+	go internal.DetectUnhealthyContainer()
+	go internal.DetectFileTamper()
+	go internal.ContainerEventMonitor()
+	go internal.CreateImportedContainers()
 	go internal.CronSyncImage()
+	go internal.CleanUploadSessions()
+	internal.StartFailoverWorkers(common.Config.Controller.FailoverWorkers)
 	return s, nil
 }
@@ -0,0 +1,353 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+	"scmc/rpc/pb/logging"
+)
+
+const defaultBackupDescTemplate = "定时备份 ${DATE} ${TIME}"
+
+func renderBackupDesc(tmpl, containerName string, t time.Time) string {
+	if tmpl == "" {
+		tmpl = defaultBackupDescTemplate
+	}
+	return strings.NewReplacer(
+		"${NAME}", containerName,
+		"${DATE}", t.Format("2006-01-02"),
+		"${TIME}", t.Format("15:04:05"),
+	).Replace(tmpl)
+}
+
+// 检查定时备份参数 返回下次执行时间
+func checkBackupSchedule(s *pb.BackupSchedule) (int64, error) {
+	if s == nil || s.KeepCount < 0 || s.KeepDays < 0 {
+		return 0, rpc.ErrInvalidArgument
+	}
+
+	c, err := parseCron(s.Cron)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "cron表达式错误: %v", err)
+	}
+
+	next := c.next(time.Now())
+	if next.IsZero() {
+		return 0, status.Errorf(codes.InvalidArgument, "cron表达式无可执行时间")
+	}
+
+	// 以最长的容器名检查描述长度
+	if !isValidBackupDesc(renderBackupDesc(s.DescTemplate, strings.Repeat("x", 50), next)) {
+		return 0, status.Errorf(codes.InvalidArgument, "参数错误：备份描述无效")
+	}
+	return next.Unix(), nil
+}
+
+// 角色仅允许访问自己的容器时 只能操作这些容器的定时备份
+func checkBackupScheduleAccess(ctx context.Context, sch *model.BackupSchedule) error {
+	userID, ok := containerOwnerScope(ctx)
+	if !ok {
+		return nil
+	}
+
+	cfgs, err := model.GetContainerConfigsByUUID(sch.UUID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrContainerNotOwned
+		}
+		return rpc.ErrInternal
+	}
+
+	if !canAccessContainer(cfgs, userID) {
+		return rpc.ErrContainerNotOwned
+	}
+	return nil
+}
+
+func (*ContainerServer) CreateBackupSchedule(ctx context.Context, in *pb.CreateBackupScheduleRequest) (*pb.CreateBackupScheduleReply, error) {
+	if in.NodeId <= 0 || in.ContainerId == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	nextRunAt, err := checkBackupSchedule(in.Schedule)
+	if err != nil {
+		return nil, err
+	} else if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
+	}
+
+	cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	data := model.BackupSchedule{
+		UUID:         cfgs.UUID,
+		Cron:         strings.TrimSpace(in.Schedule.Cron),
+		KeepCount:    in.Schedule.KeepCount,
+		KeepDays:     in.Schedule.KeepDays,
+		DescTemplate: in.Schedule.DescTemplate,
+		WithMounts:   in.Schedule.WithMounts,
+		Disabled:     in.Schedule.Disabled,
+		NextRunAt:    nextRunAt,
+		Creator:      getUserIDFromContext(ctx),
+	}
+	if err := model.CreateBackupSchedule(&data); err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.CreateBackupScheduleReply{Id: data.ID}, nil
+}
+
+func (*ContainerServer) UpdateBackupSchedule(ctx context.Context, in *pb.UpdateBackupScheduleRequest) (*pb.UpdateBackupScheduleReply, error) {
+	if in.Id <= 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	nextRunAt, err := checkBackupSchedule(in.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := model.QueryBackupScheduleByID(in.Id)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	} else if err := checkBackupScheduleAccess(ctx, data); err != nil {
+		return nil, err
+	}
+
+	data.Cron = strings.TrimSpace(in.Schedule.Cron)
+	data.KeepCount = in.Schedule.KeepCount
+	data.KeepDays = in.Schedule.KeepDays
+	data.DescTemplate = in.Schedule.DescTemplate
+	data.WithMounts = in.Schedule.WithMounts
+	data.Disabled = in.Schedule.Disabled
+	data.NextRunAt = nextRunAt
+	if err := model.UpdateBackupSchedule(data); err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.UpdateBackupScheduleReply{}, nil
+}
+
+func (*ContainerServer) RemoveBackupSchedule(ctx context.Context, in *pb.RemoveBackupScheduleRequest) (*pb.RemoveBackupScheduleReply, error) {
+	ids := uniqueInt64(in.Ids)
+	if len(ids) == 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	for _, id := range ids {
+		data, err := model.QueryBackupScheduleByID(id)
+		if err != nil {
+			if err == model.ErrRecordNotFound {
+				return nil, rpc.ErrNotFound
+			}
+			return nil, rpc.ErrInternal
+		} else if err := checkBackupScheduleAccess(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := model.RemoveBackupSchedule(ids); err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.RemoveBackupScheduleReply{}, nil
+}
+
+func (*ContainerServer) ListBackupSchedule(ctx context.Context, in *pb.ListBackupScheduleRequest) (*pb.ListBackupScheduleReply, error) {
+	var uuid string
+	if in.NodeId > 0 || in.ContainerId != "" {
+		cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+		if err != nil {
+			if err == model.ErrRecordNotFound {
+				return nil, rpc.ErrNotFound
+			}
+			return nil, rpc.ErrInternal
+		}
+		uuid = cfgs.UUID
+	}
+
+	schedules, err := model.ListBackupSchedule(uuid)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	userID, restricted := containerOwnerScope(ctx)
+	reply := pb.ListBackupScheduleReply{}
+	for _, s := range schedules {
+		item := pb.BackupSchedule{
+			Id:           s.ID,
+			Uuid:         s.UUID,
+			Cron:         s.Cron,
+			KeepCount:    s.KeepCount,
+			KeepDays:     s.KeepDays,
+			DescTemplate: s.DescTemplate,
+			WithMounts:   s.WithMounts,
+			Disabled:     s.Disabled,
+			LastRunAt:    s.LastRunAt,
+			LastError:    s.LastError,
+			NextRunAt:    s.NextRunAt,
+			CreatedAt:    s.CreatedAt,
+		}
+
+		cfgs, err := model.GetContainerConfigsByUUID(s.UUID)
+		if err == nil {
+			item.NodeId, item.ContainerId = cfgs.NodeID, cfgs.ContainerID
+		}
+		if restricted && (err != nil || !canAccessContainer(cfgs, userID)) {
+			continue
+		}
+		reply.Data = append(reply.Data, &item)
+	}
+
+	return &reply, nil
+}
+
+func backupScheduleLog(eventType logging.EVENT_TYPE, cfgs *model.ContainerConfigs, detail string, err error) {
+	r := &model.RuntimeLog{
+		EventType:   int64(eventType),
+		EventType_:  eventType.String(),
+		EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+		NodeId:      cfgs.NodeID,
+		Target:      fmt.Sprintf("容器=%v", cfgs.ContainerName),
+		Detail:      detail,
+	}
+	if n, e := model.QueryNodeByID(cfgs.NodeID); e == nil {
+		r.NodeInfo = fmt.Sprintf("%s (%s)", n.Name, n.Address)
+	}
+	if s, _ := status.FromError(err); s != nil {
+		r.StatusCode = int64(s.Code())
+		r.Error = s.Message()
+	}
+	appendRuntimeLog(r)
+}
+
+// 执行一次定时备份 并计算下次执行时间
+func runBackupSchedule(sch *model.BackupSchedule, cfgs *model.ContainerConfigs, now time.Time) {
+	desc := renderBackupDesc(sch.DescTemplate, cfgs.ContainerName, now)
+	backup, err := createBackup(cfgs.NodeID, cfgs.ContainerID, desc, sch.WithMounts, sch.ID)
+
+	detail := fmt.Sprintf("定时备份ID=%v", sch.ID)
+	sch.LastRunAt, sch.LastError = now.Unix(), ""
+	if err != nil {
+		log.Infof("scheduled backup id=%v container=%v err=%v", sch.ID, cfgs.ContainerName, err)
+		if s, _ := status.FromError(err); s != nil {
+			sch.LastError = s.Message()
+		}
+	} else {
+		detail += fmt.Sprintf(" 备份名=%v", backup.BackupName)
+	}
+	backupScheduleLog(logging.EVENT_TYPE_SCHEDULED_BACKUP, cfgs, detail, err)
+
+	sch.NextRunAt = 0
+	if c, err := parseCron(sch.Cron); err == nil {
+		if next := c.next(now); !next.IsZero() {
+			sch.NextRunAt = next.Unix()
+		}
+	}
+	if err := model.UpdateBackupSchedule(sch); err != nil {
+		log.Warnf("update backup schedule id=%v err=%v", sch.ID, err)
+	}
+}
+
+// 按保留策略选出定时备份产生的旧备份, backups按创建时间倒序
+// 失败的备份记录保留至下一次备份成功, 最近一次成功的备份始终保留
+func expiredScheduledBackups(sch *model.BackupSchedule, backups []*model.ContainerBackup, now time.Time) []*model.ContainerBackup {
+	expireAt := now.AddDate(0, 0, -int(sch.KeepDays)).Unix()
+	var (
+		kept      int64
+		succeeded bool
+		r         []*model.ContainerBackup
+	)
+	for _, b := range backups {
+		var remove, latest bool
+		if b.Status == int8(pb.BACKUP_STATUS_SUCCEED) {
+			kept++
+			remove = sch.KeepCount > 0 && kept > sch.KeepCount
+			latest = !succeeded
+			succeeded = true
+		} else {
+			remove = succeeded
+		}
+		if sch.KeepDays > 0 && b.CreatedAt < expireAt && !latest {
+			remove = true
+		}
+		if remove {
+			r = append(r, b)
+		}
+	}
+	return r
+}
+
+// 删除定时备份产生的旧备份
+func pruneScheduledBackups(sch *model.BackupSchedule, cfgs *model.ContainerConfigs, now time.Time) {
+	if sch.KeepCount <= 0 && sch.KeepDays <= 0 {
+		return
+	}
+
+	backups, err := model.QueryScheduledContainerBackup(sch.ID)
+	if err != nil {
+		return
+	}
+
+	var removed []string
+	for _, b := range expiredScheduledBackups(sch, backups, now) {
+		if err := removeBackup(b, cfgs); err != nil {
+			log.Infof("prune backup id=%v image=%v err=%v", b.ID, b.ImageRef, err)
+			continue
+		}
+		removed = append(removed, b.BackupName)
+	}
+
+	if len(removed) > 0 {
+		backupScheduleLog(logging.EVENT_TYPE_PRUNE_BACKUP, cfgs, fmt.Sprintf("定时备份ID=%v 删除备份=%v", sch.ID, removed), nil)
+	}
+}
+
+func runBackupSchedules() {
+	schedules, err := model.ListBackupSchedule("")
+	if err != nil {
+		log.Infof("get backup schedules from DB err=%v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, sch := range schedules {
+		cfgs, err := model.GetContainerConfigsByUUID(sch.UUID)
+		if err != nil {
+			continue
+		}
+
+		// 控制器停止期间错过的多次执行只补执行一次
+		if !sch.Disabled && sch.NextRunAt > 0 && sch.NextRunAt <= now.Unix() && cfgs.ContainerID != "" {
+			runBackupSchedule(sch, cfgs, now)
+		}
+		pruneScheduledBackups(sch, cfgs, now)
+	}
+}
+
+// 主控制器执行定时备份
+func RunBackupSchedules() {
+	for {
+		if isMaster() {
+			runBackupSchedules()
+		}
+		time.Sleep(time.Minute)
+	}
+}
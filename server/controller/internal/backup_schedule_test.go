@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+)
+
+func TestRenderBackupDesc(t *testing.T) {
+	now := time.Date(2022, 3, 1, 2, 30, 5, 0, time.UTC)
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{"", "定时备份 2022-03-01 02:30:05"},
+		{"${NAME} daily backup ${DATE}", "web daily backup 2022-03-01"},
+		{"${NAME}-${NAME} ${TIME}", "web-web 02:30:05"},
+		{"${name} ${UNKNOWN}", "${name} ${UNKNOWN}"},
+	}
+	for _, tt := range tests {
+		if got := renderBackupDesc(tt.tmpl, "web", now); got != tt.want {
+			t.Errorf("renderBackupDesc(%q) = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+}
+
+func TestCheckBackupSchedule(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.BackupSchedule
+		code codes.Code
+	}{
+		{"ok", &pb.BackupSchedule{Cron: "30 2 * * *", KeepCount: 7, KeepDays: 30}, codes.OK},
+		{"nil", nil, codes.InvalidArgument},
+		{"keep count", &pb.BackupSchedule{Cron: "@daily", KeepCount: -1}, codes.InvalidArgument},
+		{"keep days", &pb.BackupSchedule{Cron: "@daily", KeepDays: -1}, codes.InvalidArgument},
+		{"cron", &pb.BackupSchedule{Cron: "30 2 * *"}, codes.InvalidArgument},
+		{"never", &pb.BackupSchedule{Cron: "0 0 31 2 *"}, codes.InvalidArgument},
+		{"desc", &pb.BackupSchedule{Cron: "@daily", DescTemplate: strings.Repeat("a", 160) + "${NAME}"}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		next, err := checkBackupSchedule(tt.in)
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: checkBackupSchedule err = %v, want %v", tt.name, err, tt.code)
+		} else if err == nil && next <= time.Now().Unix() {
+			t.Errorf("%s: checkBackupSchedule next = %v, want future time", tt.name, next)
+		}
+	}
+}
+
+func TestExpiredScheduledBackups(t *testing.T) {
+	now := time.Date(2022, 3, 31, 0, 0, 0, 0, time.UTC)
+	day := func(n int) int64 { return now.AddDate(0, 0, -n).Unix() }
+	succeed, failed := int8(pb.BACKUP_STATUS_SUCCEED), int8(pb.BACKUP_STATUS_FAILED)
+
+	// 按创建时间倒序
+	backups := []*model.ContainerBackup{
+		{ID: 6, Status: failed, CreatedAt: day(0)},
+		{ID: 5, Status: succeed, CreatedAt: day(1)},
+		{ID: 4, Status: failed, CreatedAt: day(2)},
+		{ID: 3, Status: succeed, CreatedAt: day(3)},
+		{ID: 2, Status: succeed, CreatedAt: day(10)},
+		{ID: 1, Status: succeed, CreatedAt: day(40)},
+	}
+	onlyOld := []*model.ContainerBackup{
+		{ID: 3, Status: failed, CreatedAt: day(31)},
+		{ID: 2, Status: succeed, CreatedAt: day(40)},
+		{ID: 1, Status: succeed, CreatedAt: day(50)},
+	}
+
+	tests := []struct {
+		name      string
+		backups   []*model.ContainerBackup
+		keepCount int64
+		keepDays  int64
+		want      []int64
+	}{
+		{"unlimited", backups, 0, 0, []int64{4}},
+		{"keep count", backups, 2, 0, []int64{4, 2, 1}},
+		{"keep days", backups, 0, 7, []int64{4, 2, 1}},
+		{"keep both", backups, 1, 30, []int64{4, 3, 2, 1}},
+		{"latest succeed kept", onlyOld, 0, 30, []int64{3, 1}},
+		{"latest succeed and count", onlyOld, 5, 0, nil},
+		{"no succeed", []*model.ContainerBackup{{ID: 1, Status: failed, CreatedAt: day(1)}}, 1, 0, nil},
+	}
+	for _, tt := range tests {
+		sch := model.BackupSchedule{KeepCount: tt.keepCount, KeepDays: tt.keepDays}
+		var got []int64
+		for _, b := range expiredScheduledBackups(&sch, tt.backups, now) {
+			got = append(got, b.ID)
+		}
+		if !equalInt64s(got, tt.want) {
+			t.Errorf("%s: expiredScheduledBackups = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,617 @@
+package internal
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/common"
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+)
+
+// 备份文件为tar归档 清单最后写入并签名
+const (
+	backupConfigsFile  = "configs.json"
+	backupSecurityFile = "security.json"
+	backupManifestFile = "manifest.json"
+	backupSignFile     = "manifest.sig"
+
+	backupManifestVersion = 1
+	backupChunkSize       = 1024 * 1024
+	maxBackupFileSize     = 64 << 30
+	maxBackupMetaSize     = 1 << 20
+)
+
+type backupFileInfo struct {
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+	Destination string `json:"destination,omitempty"` // 挂载数据归档对应的容器内路径
+	Type        string `json:"type,omitempty"`
+}
+
+type backupManifest struct {
+	Version       int               `json:"version"`
+	UUID          string            `json:"uuid"`
+	ContainerName string            `json:"container_name"`
+	BackupName    string            `json:"backup_name"`
+	BackupDesc    string            `json:"backup_desc"`
+	ImageRef      string            `json:"image_ref"`
+	ImageID       string            `json:"image_id"`
+	CreatedAt     int64             `json:"created_at"`
+	ExportedAt    int64             `json:"exported_at"`
+	Files         []*backupFileInfo `json:"files"`
+}
+
+var (
+	backupSignKeyGuard sync.Mutex
+
+	errBackupSignKeyMissing = errors.New("backup sign key not found")
+)
+
+// 读取备份文件签名密钥, 文件内容为十六进制的ed25519私钥种子
+// 不存在时生成, 配置虚拟IP时多个控制器须使用相同的密钥, 不自动生成
+func backupSignKey() (ed25519.PrivateKey, error) {
+	backupSignKeyGuard.Lock()
+	defer backupSignKeyGuard.Unlock()
+
+	path := common.Config.Controller.BackupSignKey
+	data, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && common.Config.Controller.VirtualIP != "" {
+		return nil, errBackupSignKeyMissing
+	} else if errors.Is(err, os.ErrNotExist) {
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+		key := ed25519.NewKeyFromSeed(seed)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(seed)), 0600); err != nil {
+			return nil, err
+		}
+		pub := hex.EncodeToString(key.Public().(ed25519.PublicKey))
+		if err := ioutil.WriteFile(path+".pub", []byte(pub), 0644); err != nil {
+			log.Warnf("write backup sign public key err=%v", err)
+		}
+		return key, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	seed, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid backup sign key")
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func backupSignKeyError(err error) error {
+	if err == errBackupSignKeyMissing {
+		return status.Errorf(codes.FailedPrecondition, "备份签名密钥不存在, 请在各控制器部署相同的密钥文件")
+	}
+	log.Warnf("load backup sign key err=%v", err)
+	return rpc.ErrInternal
+}
+
+type downloadBackupWriter struct {
+	stream pb.Container_DownloadBackupServer
+}
+
+func (w downloadBackupWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.DownloadBackupReply{ChunkData: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// 写入tar归档并记录各文件的大小和摘要
+type backupFileWriter struct {
+	tw    *tar.Writer
+	files []*backupFileInfo
+	cur   *backupFileInfo
+	hash  hash.Hash
+	left  int64
+}
+
+func (w *backupFileWriter) begin(f *backupFileInfo) error {
+	if err := w.end(); err != nil {
+		return err
+	}
+
+	header := tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     f.Name,
+		Size:     f.Size,
+		Mode:     0600,
+		ModTime:  time.Now(),
+	}
+	if err := w.tw.WriteHeader(&header); err != nil {
+		return err
+	}
+	w.cur, w.hash, w.left = f, sha256.New(), f.Size
+	return nil
+}
+
+func (w *backupFileWriter) Write(p []byte) (int, error) {
+	if w.cur == nil || int64(len(p)) > w.left {
+		return 0, errors.New("unexpected backup file data")
+	}
+
+	n, err := w.tw.Write(p)
+	w.hash.Write(p[:n])
+	w.left -= int64(n)
+	return n, err
+}
+
+func (w *backupFileWriter) end() error {
+	if w.cur == nil {
+		return nil
+	} else if w.left != 0 {
+		return errors.New("incomplete backup file " + w.cur.Name)
+	}
+
+	w.cur.Sha256 = hex.EncodeToString(w.hash.Sum(nil))
+	w.files = append(w.files, w.cur)
+	w.cur = nil
+	return nil
+}
+
+func (w *backupFileWriter) writeFile(name string, data []byte) error {
+	if err := w.begin(&backupFileInfo{Name: name, Size: int64(len(data))}); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.end()
+}
+
+func (*ContainerServer) DownloadBackup(in *pb.DownloadBackupRequest, stream pb.Container_DownloadBackupServer) error {
+	backup, err := model.QueryContainerBackupByID(in.Id)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	} else if backup.Status != int8(pb.BACKUP_STATUS_SUCCEED) {
+		return status.Errorf(codes.FailedPrecondition, "备份未完成, 无法下载")
+	}
+
+	cfgs, err := model.GetContainerConfigsByUUID(backup.UUID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+	if userID, ok := containerOwnerScope(stream.Context()); ok && !canAccessContainer(cfgs, userID) {
+		return rpc.ErrContainerNotOwned
+	}
+
+	configs := getStoredConfigs(cfgs)
+	if configs == nil {
+		return status.Errorf(codes.FailedPrecondition, "缺少容器配置, 无法下载备份")
+	}
+	configs.Uuid = cfgs.UUID
+
+	key, err := backupSignKey()
+	if err != nil {
+		return backupSignKeyError(err)
+	}
+
+	nodeInfo, err := model.QueryNodeByID(backup.NodeID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	archives := getBackupArchives(backup)
+	cli := pb.NewContainerClient(conn)
+	agentStream, err := cli.SaveBackup(ctx, &pb.SaveBackupRequest{
+		ImageRef: backup.ImageRef,
+		Archives: archives,
+	})
+	if err != nil {
+		log.Warnf("SaveBackup open agent stream err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	bw := bufio.NewWriterSize(downloadBackupWriter{stream}, backupChunkSize)
+	w := backupFileWriter{tw: tar.NewWriter(bw)}
+
+	configsData, _ := json.Marshal(configs)
+	if err := w.writeFile(backupConfigsFile, configsData); err != nil {
+		return err
+	} else if err := w.writeFile(backupSecurityFile, []byte(cfgs.SecurityConfig)); err != nil {
+		return err
+	}
+
+	for {
+		c, err := agentStream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Infof("SaveBackup receive from agent err=%v", err)
+			return err
+		}
+
+		if c.FileName != "" {
+			f := backupFileInfo{Name: c.FileName, Size: c.FileSize}
+			for _, a := range archives {
+				if model.BackupMountsDir+filepath.Base(a.File) == c.FileName {
+					f.Destination, f.Type = a.Destination, a.Type
+				}
+			}
+			if err := w.begin(&f); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(c.ChunkData); err != nil {
+			log.Warnf("DownloadBackup write file err=%v", err)
+			return rpc.ErrInternal
+		}
+	}
+	if err := w.end(); err != nil {
+		log.Warnf("DownloadBackup err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	manifest := backupManifest{
+		Version:       backupManifestVersion,
+		UUID:          cfgs.UUID,
+		ContainerName: cfgs.ContainerName,
+		BackupName:    backup.BackupName,
+		BackupDesc:    backup.BackupDesc,
+		ImageRef:      backup.ImageRef,
+		ImageID:       backup.ImageID,
+		CreatedAt:     backup.CreatedAt,
+		ExportedAt:    time.Now().Unix(),
+		Files:         w.files,
+	}
+	manifestData, _ := json.MarshalIndent(&manifest, "", "  ")
+	sign := hex.EncodeToString(ed25519.Sign(key, manifestData))
+	if err := w.writeFile(backupManifestFile, manifestData); err != nil {
+		return err
+	} else if err := w.writeFile(backupSignFile, []byte(sign)); err != nil {
+		return err
+	} else if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func receiveBackupFile(stream pb.Container_UploadBackupServer, req *pb.UploadBackupRequest, dataFile string) error {
+	file, err := os.Create(dataFile)
+	if err != nil {
+		log.Errorf("cannot create file %v: %v", dataFile, err)
+		return rpc.ErrInternal
+	}
+	defer file.Close()
+
+	var size int64
+	for {
+		size += int64(len(req.ChunkData))
+		if size > maxBackupFileSize {
+			log.Errorf("backup file is too large: [%v] > [%v]", size, maxBackupFileSize)
+			return rpc.ErrInvalidArgument
+		}
+
+		if _, err := file.Write(req.ChunkData); err != nil {
+			log.Errorf("cannot write chunk data to file: %v", err)
+			return rpc.ErrInternal
+		}
+
+		if err := contextError(stream.Context()); err != nil {
+			return err
+		}
+
+		req, err = stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Errorf("cannot receive chunk data: %v", err)
+			return rpc.ErrUnknown
+		}
+	}
+}
+
+// 上传的备份文件内容
+type backupPackage struct {
+	manifest backupManifest
+	configs  pb.ContainerConfigs
+	security string
+}
+
+// 校验清单签名及各文件摘要
+func verifyBackupFile(dataFile string) (*backupPackage, error) {
+	file, err := os.Open(dataFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		files = make(map[string]*backupFileInfo)
+		metas = make(map[string][]byte)
+		tr    = tar.NewReader(file)
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		} else if _, ok := files[header.Name]; ok {
+			return nil, errors.New("duplicate file " + header.Name)
+		}
+
+		h := sha256.New()
+		var r io.Reader = tr
+		switch header.Name {
+		case backupConfigsFile, backupSecurityFile, backupManifestFile, backupSignFile:
+			data, err := ioutil.ReadAll(io.LimitReader(tr, maxBackupMetaSize))
+			if err != nil {
+				return nil, err
+			}
+			metas[header.Name] = data
+			r = strings.NewReader(string(data))
+		}
+
+		size, err := io.Copy(h, r)
+		if err != nil {
+			return nil, err
+		}
+		files[header.Name] = &backupFileInfo{Name: header.Name, Size: size, Sha256: hex.EncodeToString(h.Sum(nil))}
+	}
+
+	key, err := backupSignKey()
+	if err != nil {
+		return nil, err
+	}
+	sign, err := hex.DecodeString(strings.TrimSpace(string(metas[backupSignFile])))
+	if err != nil || !ed25519.Verify(key.Public().(ed25519.PublicKey), metas[backupManifestFile], sign) {
+		return nil, errors.New("invalid manifest signature")
+	}
+
+	var p backupPackage
+	if err := json.Unmarshal(metas[backupManifestFile], &p.manifest); err != nil {
+		return nil, err
+	} else if p.manifest.Version != backupManifestVersion || p.manifest.UUID == "" || p.manifest.ImageRef == "" {
+		return nil, errors.New("invalid manifest")
+	}
+
+	// 除清单外的文件均需在清单中且摘要一致
+	if len(files) != len(p.manifest.Files)+2 {
+		return nil, errors.New("file list mismatch")
+	}
+	for _, f := range p.manifest.Files {
+		v, ok := files[f.Name]
+		if !ok || v.Size != f.Size || v.Sha256 != f.Sha256 {
+			return nil, errors.New("file checksum mismatch " + f.Name)
+		}
+	}
+	if _, ok := files[model.BackupImageFile]; !ok {
+		return nil, errors.New("missing backup image")
+	}
+
+	if err := json.Unmarshal(metas[backupConfigsFile], &p.configs); err != nil {
+		return nil, err
+	}
+	p.security = string(metas[backupSecurityFile])
+	return &p, nil
+}
+
+// 将备份镜像及挂载数据发送至节点加载
+func loadBackupFile(ctx context.Context, conn *grpc.ClientConn, dataFile string, p *backupPackage, backupName string) (*pb.LoadBackupReply, error) {
+	file, err := os.Open(dataFile)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+	defer file.Close()
+
+	cli := pb.NewContainerClient(conn)
+	agentStream, err := cli.LoadBackup(ctx)
+	if err != nil {
+		log.Warnf("LoadBackup open agent stream err=%v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	files := make(map[string]*backupFileInfo)
+	for _, f := range p.manifest.Files {
+		files[f.Name] = f
+	}
+
+	req := pb.LoadBackupRequest{BackupName: backupName, ImageRef: p.manifest.ImageRef}
+	buffer := make([]byte, backupChunkSize)
+	tr := tar.NewReader(file)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, rpc.ErrInternal
+		}
+
+		f, ok := files[header.Name]
+		if !ok || (f.Name != model.BackupImageFile && !strings.HasPrefix(f.Name, model.BackupMountsDir)) {
+			continue
+		}
+
+		req.Chunk = &pb.BackupFileChunk{FileName: f.Name, FileSize: f.Size}
+		if f.Name != model.BackupImageFile {
+			req.Archive = &pb.BackupArchive{Destination: f.Destination, Type: f.Type, Size: f.Size, Sha256: f.Sha256}
+		}
+		for {
+			n, err := io.ReadFull(tr, buffer)
+			if n > 0 || req.Chunk.FileName != "" {
+				req.Chunk.ChunkData = buffer[:n]
+				if err := agentStream.Send(&req); err != nil {
+					log.Infof("LoadBackup send to agent err=%v", err)
+					_, err = agentStream.CloseAndRecv()
+					return nil, err
+				}
+				req = pb.LoadBackupRequest{Chunk: &pb.BackupFileChunk{}}
+			}
+
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			} else if err != nil {
+				return nil, rpc.ErrInternal
+			}
+		}
+	}
+
+	reply, err := agentStream.CloseAndRecv()
+	if err != nil {
+		log.Warnf("agent LoadBackup err=%v", err)
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (*ContainerServer) UploadBackup(stream pb.Container_UploadBackupServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		log.Errorf("cannot receive upload backup info %v", err)
+		return rpc.ErrUnknown
+	} else if req.NodeId <= 0 {
+		return rpc.ErrInvalidArgument
+	}
+
+	// 接收文件前检查密钥 避免上传完成后才失败
+	if _, err := backupSignKey(); err != nil {
+		return backupSignKeyError(err)
+	}
+
+	nodeInfo, err := model.QueryNodeByID(req.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	file, err := ioutil.TempFile("", "scmc-backup-*.tar")
+	if err != nil {
+		log.Errorf("create temp file err=%v", err)
+		return rpc.ErrInternal
+	}
+	dataFile := file.Name()
+	file.Close()
+	defer os.Remove(dataFile)
+
+	if err := receiveBackupFile(stream, req, dataFile); err != nil {
+		return err
+	}
+
+	p, err := verifyBackupFile(dataFile)
+	if err != nil {
+		log.Warnf("verify backup file err=%v", err)
+		return status.Errorf(codes.InvalidArgument, "备份文件校验失败")
+	}
+
+	// 原容器配置不存在时 使用备份文件中的配置
+	ctx := stream.Context()
+	created := false
+	cfgs, err := model.GetContainerConfigsByUUID(p.manifest.UUID)
+	if err == model.ErrRecordNotFound {
+		p.configs.SecurityConfig = nil
+		if p.security != "" {
+			var secCfg pb.SecurityConfig
+			if err := json.Unmarshal([]byte(p.security), &secCfg); err == nil {
+				p.configs.SecurityConfig = &secCfg
+			}
+		}
+		if err := checkCreateConfigs(ctx, &p.configs); err != nil {
+			return err
+		} else if err := checkDevicesAllowed(nodeInfo, p.configs.Devices); err != nil {
+			return err
+		}
+
+		cfgs = &model.ContainerConfigs{
+			NodeID:         nodeInfo.ID,
+			UUID:           p.manifest.UUID,
+			ContainerName:  p.configs.Name,
+			SecurityConfig: p.security,
+			Creator:        getUserIDFromContext(ctx),
+		}
+		setStoredConfigs(cfgs, &p.configs)
+		if err := model.CreateContainerConfigs(cfgs); err != nil {
+			return rpc.ErrInternal
+		}
+		created = true
+	} else if err != nil {
+		return rpc.ErrInternal
+	} else if userID, ok := containerOwnerScope(ctx); ok && !canAccessContainer(cfgs, userID) {
+		return rpc.ErrContainerNotOwned
+	}
+
+	backupName := newBackupName()
+	backup, err := model.CreateContainerBackup(nodeInfo.ID, cfgs.UUID, backupName, p.manifest.BackupDesc, 0)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	reply, err := loadBackupFile(ctx, conn, dataFile, p, backupName)
+	if err != nil {
+		if err := model.RemoveContainerBackup(backup); err != nil {
+			log.Warnf("remove backup id=%v err=%v", backup.ID, err)
+		}
+		if created {
+			if err := model.RemoveContainerConfigsByID(cfgs.ID); err != nil {
+				log.Warnf("remove container configs uuid=%v err=%v", cfgs.UUID, err)
+			}
+		}
+		return err
+	}
+
+	backup.ImageRef = p.manifest.ImageRef
+	backup.ImageID = reply.ImageId
+	backup.ImageSize = reply.ImageSize
+	backup.Status = int8(pb.BACKUP_STATUS_SUCCEED)
+	setBackupArchives(backup, reply.Archives)
+	if err := model.UpdateContainerBackup(backup); err != nil {
+		return rpc.ErrInternal
+	}
+
+	return stream.SendAndClose(&pb.UploadBackupReply{Id: backup.ID, Uuid: cfgs.UUID})
+}
@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"archive/tar"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/common"
+	"scmc/model"
+)
+
+func useTestBackupSignKey(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "backup-sign")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	old := common.Config.Controller
+	common.Config.Controller.BackupSignKey = filepath.Join(dir, "sign.key")
+	common.Config.Controller.VirtualIP = ""
+	t.Cleanup(func() { common.Config.Controller = old })
+	return dir
+}
+
+type testBackupFile struct {
+	name string
+	data string
+}
+
+// 按DownloadBackup的格式生成备份文件
+// modify在签名前修改清单, tamper在签名后修改清单
+func writeTestBackupFile(t *testing.T, file string, files []testBackupFile, key ed25519.PrivateKey, modify, tamper func(m *backupManifest), extra ...testBackupFile) {
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatalf("create %v: %v", file, err)
+	}
+	defer f.Close()
+
+	w := backupFileWriter{tw: tar.NewWriter(f)}
+	for _, item := range files {
+		if err := w.writeFile(item.name, []byte(item.data)); err != nil {
+			t.Fatalf("write %v: %v", item.name, err)
+		}
+	}
+
+	manifest := backupManifest{
+		Version:  backupManifestVersion,
+		UUID:     "uuid",
+		ImageRef: "backup:name",
+		Files:    w.files,
+	}
+	if modify != nil {
+		modify(&manifest)
+	}
+	data, _ := json.Marshal(&manifest)
+	sign := hex.EncodeToString(ed25519.Sign(key, data))
+	if tamper != nil {
+		tamper(&manifest)
+		data, _ = json.Marshal(&manifest)
+	}
+
+	extra = append([]testBackupFile{{backupManifestFile, string(data)}, {backupSignFile, sign}}, extra...)
+	for _, item := range extra {
+		if err := w.writeFile(item.name, []byte(item.data)); err != nil {
+			t.Fatalf("write %v: %v", item.name, err)
+		}
+	}
+	if err := w.tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+}
+
+func TestVerifyBackupFile(t *testing.T) {
+	dir := useTestBackupSignKey(t)
+	key, err := backupSignKey()
+	if err != nil {
+		t.Fatalf("backupSignKey: %v", err)
+	}
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+
+	files := []testBackupFile{
+		{backupConfigsFile, `{"name":"web","image":"nginx:1.20"}`},
+		{backupSecurityFile, "{}"},
+		{model.BackupImageFile, "image data"},
+		{model.BackupMountsDir + "0.tar.gz", "archive data"},
+	}
+
+	tests := []struct {
+		name   string
+		files  []testBackupFile
+		key    ed25519.PrivateKey
+		modify func(m *backupManifest)
+		tamper func(m *backupManifest)
+		extra  []testBackupFile
+		ok     bool
+	}{
+		{name: "ok", files: files, key: key, ok: true},
+		{name: "other key", files: files, key: otherKey},
+		{name: "manifest changed", files: files, key: key, tamper: func(m *backupManifest) { m.ImageRef = "other:name" }},
+		{name: "checksum changed", files: files, key: key, modify: func(m *backupManifest) { m.Files[2].Sha256 = m.Files[3].Sha256 }},
+		{name: "size changed", files: files, key: key, modify: func(m *backupManifest) { m.Files[2].Size++ }},
+		{name: "extra file", files: files, key: key, extra: []testBackupFile{{"extra", "x"}}},
+		{name: "duplicate file", files: files, key: key, extra: []testBackupFile{{model.BackupImageFile, "other image"}}},
+		{name: "missing image", files: files[:2], key: key},
+		{name: "version", files: files, key: key, modify: func(m *backupManifest) { m.Version++ }},
+	}
+	for _, tt := range tests {
+		file := filepath.Join(dir, "backup.tar")
+		writeTestBackupFile(t, file, tt.files, tt.key, tt.modify, tt.tamper, tt.extra...)
+
+		p, err := verifyBackupFile(file)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: verifyBackupFile err = %v, want ok=%v", tt.name, err, tt.ok)
+		} else if err == nil && (p.configs.Name != "web" || p.security != "{}" || p.manifest.UUID != "uuid") {
+			t.Errorf("%s: verifyBackupFile got %+v", tt.name, p)
+		}
+	}
+}
+
+func TestBackupSignKey(t *testing.T) {
+	useTestBackupSignKey(t)
+	path := common.Config.Controller.BackupSignKey
+
+	common.Config.Controller.VirtualIP = "192.168.1.100"
+	if _, err := backupSignKey(); err != errBackupSignKeyMissing {
+		t.Errorf("backupSignKey with virtual ip and no key: err = %v, want %v", err, errBackupSignKeyMissing)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("backupSignKey with virtual ip: key generated")
+	}
+	if err := backupSignKeyError(errBackupSignKeyMissing); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("backupSignKeyError = %v, want FailedPrecondition", err)
+	}
+
+	common.Config.Controller.VirtualIP = ""
+	key, err := backupSignKey()
+	if err != nil {
+		t.Fatalf("backupSignKey: %v", err)
+	}
+
+	common.Config.Controller.VirtualIP = "192.168.1.100"
+	if again, err := backupSignKey(); err != nil || !again.Equal(key) {
+		t.Errorf("backupSignKey with virtual ip and key: err = %v, key changed = %v", err, !again.Equal(key))
+	}
+
+	ioutil.WriteFile(path, []byte("bad key"), 0600)
+	if _, err := backupSignKey(); err == nil {
+		t.Errorf("backupSignKey with invalid key: want error")
+	}
+}
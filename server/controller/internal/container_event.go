@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+	"scmc/rpc/pb/logging"
+)
+
+// 各节点已处理的最新事件位置, 仅在ContainerEventMonitor中访问
+var nodeEventCursor = make(map[int64]eventCursor)
+
+type eventCursor struct {
+	epoch string // agent启动标识
+	id    int64
+}
+
+func containerEventLogs(n *model.NodeInfo, e *pb.ContainerEvent) (*model.RuntimeLog, *model.WarnLog) {
+	nodeInfo := fmt.Sprintf("%s (%s)", n.Name, n.Address)
+	newWarnLog := func(eventType logging.EVENT_TYPE, detail string) *model.WarnLog {
+		return &model.WarnLog{
+			NodeId:        n.ID,
+			NodeInfo:      nodeInfo,
+			EventType:     int64(eventType),
+			EventModule:   int64(logging.EVENT_MODULE_CONTAINER),
+			ContainerID:   e.ContainerId,
+			ContainerName: e.ContainerName,
+			Detail:        detail,
+		}
+	}
+	newRuntimeLog := func(eventType logging.EVENT_TYPE, detail string) *model.RuntimeLog {
+		return &model.RuntimeLog{
+			EventType:   int64(eventType),
+			EventType_:  eventType.String(),
+			EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+			NodeId:      n.ID,
+			NodeInfo:    nodeInfo,
+			Target:      fmt.Sprintf("容器=%v", e.ContainerName),
+			Detail:      detail,
+		}
+	}
+
+	switch {
+	case e.Action == "oom":
+		return nil, newWarnLog(logging.EVENT_TYPE_WARN_CONTAINER_OOM, "容器内存不足")
+	case !e.External:
+		// 控制器发起的操作已记录运行日志
+		return nil, nil
+	case e.Action == "die":
+		r := newRuntimeLog(logging.EVENT_TYPE_DIE_CONTAINER, fmt.Sprintf("容器退出 退出码=%d", e.ExitCode))
+		if e.ExitCode != 0 {
+			return r, newWarnLog(logging.EVENT_TYPE_WARN_CONTAINER_UNEXPECTED_EXIT, fmt.Sprintf("容器异常退出 退出码=%d", e.ExitCode))
+		}
+		return r, nil
+	default:
+		return newRuntimeLog(logging.EVENT_TYPE_CLI_CONTAINER_ACTION, fmt.Sprintf("非控制器发起的容器操作=%s", e.Action)), nil
+	}
+}
+
+// 拉取节点容器事件 只处理startAt之后的事件, 避免控制器重启后重复记录
+func syncNodeEvents(n *model.NodeInfo, startAt int64) {
+	conn, err := getAgentConn(n.Address)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	since := nodeEventCursor[n.ID]
+	cli := pb.NewContainerClient(conn)
+	r, err := cli.Events(ctx, &pb.EventsRequest{SinceId: since.id, Epoch: since.epoch})
+	if err != nil {
+		log.Infof("get container events node=%v err=%v", n.Address, err)
+		return
+	}
+
+	if since.epoch != "" && r.Epoch != since.epoch {
+		// agent重启 事件id重新计数, 返回的是重启后的全部事件
+		log.Infof("node=%v container events reset, epoch=%v last_id=%v", n.Address, r.Epoch, r.LastId)
+	}
+
+	var warnLogs []*model.WarnLog
+	if r.Gap {
+		log.Warnf("node=%v container events after id=%v partially dropped", n.Address, since.id)
+		warnLogs = append(warnLogs, &model.WarnLog{
+			NodeId:      n.ID,
+			NodeInfo:    fmt.Sprintf("%s (%s)", n.Name, n.Address),
+			EventType:   int64(logging.EVENT_TYPE_WARN_CONTAINER_EVENTS_DROPPED),
+			EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+			Detail:      "节点部分容器事件丢失, 容器日志可能不完整",
+		})
+	}
+	for _, e := range r.Events {
+		if e.Time < startAt {
+			continue
+		}
+
+		runtimeLog, warnLog := containerEventLogs(n, e)
+		if runtimeLog != nil {
+			appendRuntimeLog(runtimeLog)
+		}
+		if warnLog != nil {
+			warnLogs = append(warnLogs, warnLog)
+		}
+	}
+
+	if len(warnLogs) > 0 {
+		model.CreateWarnLog(warnLogs)
+	}
+	nodeEventCursor[n.ID] = eventCursor{epoch: r.Epoch, id: r.LastId}
+}
+
+func ContainerEventMonitor() {
+	startAt := time.Now().Unix()
+	for {
+		if isMaster() {
+			if nodes, err := model.ListNodes(); err != nil {
+				log.Infof("get node list from DB err=%v", err)
+			} else {
+				for _, n := range nodes {
+					syncNodeEvents(&n, startAt)
+				}
+			}
+		}
+		time.Sleep(time.Second * 10)
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -26,6 +29,10 @@ type ContainerServer struct {
 }
 
 func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListReply, error) {
+	if err := checkListRequest(in); err != nil {
+		return nil, err
+	}
+
 	reply := pb.ListReply{}
 
 	nodes, err := model.ListNodes()
@@ -57,6 +64,21 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 		mtx sync.Mutex
 		wg  sync.WaitGroup
 	)
+	addFailNode := func(node *model.NodeInfo, err error) {
+		reason := err.Error()
+		if s, ok := status.FromError(err); ok {
+			reason = s.Message()
+		}
+
+		mtx.Lock()
+		defer mtx.Unlock()
+		reply.FailNodes = append(reply.FailNodes, node.Address)
+		reply.FailInfos = append(reply.FailInfos, &pb.NodeFailInfo{
+			NodeId:      node.ID,
+			NodeAddress: node.Address,
+			FailReason:  reason,
+		})
+	}
 	for _, node := range nodeToQuery {
 		wg.Add(1)
 		go func(ctx context.Context, node *model.NodeInfo) {
@@ -64,10 +86,7 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 			conn, err := getAgentConn(node.Address)
 			if err != nil {
 				log.Warnf("get agent Conn address=%v: %v", node.Address, err)
-
-				mtx.Lock()
-				defer mtx.Unlock()
-				reply.FailNodes = append(reply.FailNodes, node.Address)
+				addFailNode(node, err)
 				return
 			}
 
@@ -77,10 +96,7 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 			subReply, err := cli.List(ctx_, in)
 			if err != nil {
 				log.Warnf("get container list ID=%v address=%v: %v", node.ID, node.Address, err)
-
-				mtx.Lock()
-				defer mtx.Unlock()
-				reply.FailNodes = append(reply.FailNodes, node.Address)
+				addFailNode(node, err)
 				return
 			}
 
@@ -96,45 +112,132 @@ func (s *ContainerServer) List(ctx context.Context, in *pb.ListRequest) (*pb.Lis
 
 	wg.Wait()
 
+	if in.CreatedBy > 0 {
+		reply.Containers, err = filterContainersByConfigs(reply.Containers, func(c *model.ContainerConfigs) bool {
+			return c.Creator == in.CreatedBy
+		})
+		if err != nil {
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	if userID, ok := containerOwnerScope(ctx); ok {
+		reply.Containers, err = filterContainersByConfigs(reply.Containers, func(c *model.ContainerConfigs) bool {
+			return canAccessContainer(c, userID)
+		})
+		if err != nil {
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	if reply.Containers, reply.NextPageToken, err = paginateContainers(in, reply.Containers); err != nil {
+		return nil, err
+	}
 	return &reply, nil
 }
 
-func (s *ContainerServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
-	if in.Configs == nil || in.NodeId <= 0 {
-		return nil, rpc.ErrInvalidArgument
-	} else if !isValidContainerName(in.Configs.Name) {
-		return nil, status.Errorf(codes.InvalidArgument, "容器名参数错误")
-	} else if !isValidContainerDesc(in.Configs.Desc) {
-		return nil, status.Errorf(codes.InvalidArgument, "容器描述参数错误")
+// 检查主机端口是否已被节点上其他scmc容器占用
+func checkPortConflict(conn *grpc.ClientConn, ports []*pb.Port, exceptID string) error {
+	if len(ports) == 0 {
+		return nil
 	}
 
-	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	r, err := ListContainer(conn, true)
 	if err != nil {
-		if err == model.ErrRecordNotFound {
-			return nil, rpc.ErrNotFound
+		log.Warnf("ListContainer err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	for _, c := range r.Containers {
+		if c.Info == nil {
+			continue
+		} else if _, ok := c.Info.Labels["KS_SCMC_UUID"]; !ok {
+			continue
+		} else if exceptID != "" && c.Info.Id == exceptID {
+			continue
+		}
+
+		for _, p := range ports {
+			for _, used := range c.Info.Ports {
+				if isPortConflict(p, used) {
+					return status.Error(codes.AlreadyExists, fmt.Sprintf("主机端口%d已被容器%s占用", p.PublicPort, c.Info.Name))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// 检查直通设备是否在节点允许列表中
+func checkDevicesAllowed(n *model.NodeInfo, devices []*pb.DeviceMapping) error {
+	allowlist := nodeAllowedDevices(n)
+	for _, d := range devices {
+		if !model.IsDeviceAllowed(allowlist, d.PathOnHost) {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("节点%s不允许直通设备%s", n.Name, d.PathOnHost))
+		}
+	}
+	return nil
+}
+
+// 容器配置参数及权限检查 创建和导入容器共用
+func checkCreateConfigs(ctx context.Context, configs *pb.ContainerConfigs) error {
+	if !isValidContainerName(configs.Name) {
+		return status.Errorf(codes.InvalidArgument, "容器名参数错误")
+	} else if !isValidContainerDesc(configs.Desc) {
+		return status.Errorf(codes.InvalidArgument, "容器描述参数错误")
+	} else if !isValidHealthCheck(configs.HealthCheck) {
+		return status.Errorf(codes.InvalidArgument, "健康检查参数错误")
+	} else if !isValidPorts(configs.Ports) {
+		return status.Errorf(codes.InvalidArgument, "端口映射参数错误")
+	} else if !isValidDevices(configs.Devices) {
+		return status.Errorf(codes.InvalidArgument, "设备直通参数错误")
+	} else if !isValidHardeningConfig(configs.SecurityConfig) {
+		return status.Errorf(codes.InvalidArgument, "容器加固配置参数错误")
+	} else if !isValidResourceLimit(configs.ResouceLimit) {
+		return status.Errorf(codes.InvalidArgument, "资源限制参数错误")
+	} else if !isValidNetworkBandwidth(configs.Networks) {
+		return status.Errorf(codes.InvalidArgument, "网络带宽限制参数错误")
+	}
+
+	if common.NeedCheckAuth() && common.NeedCheckPerm() {
+		perms, _ := ctx.Value("PERMS").([]*user.Permission)
+		if len(configs.Devices) > 0 && !server.HasPerm(user.PERMISSION_CONTAINER_DEVICE, perms) {
+			return rpc.ErrContainerDeviceNoPerm
+		}
+		if configs.SecurityConfig != nil && !isEmptySecurityConfig(configs.SecurityConfig) && !server.HasPerm(user.PERMISSION_CONTAINER_CONF_SEC, perms) {
+			return rpc.ErrContainerSecurityConfigNoPerm
 		}
-		return nil, rpc.ErrInternal
 	}
 
+	return nil
+}
+
+// 在指定节点创建容器并记录容器配置
+func createContainer(nodeInfo *model.NodeInfo, in *pb.CreateRequest, creator int64) (*pb.CreateReply, error) {
 	conn, err := getAgentConn(nodeInfo.Address)
 	if err != nil {
 		return nil, rpc.ErrInternal
 	}
 
+	if err := checkPortConflict(conn, in.Configs.Ports, ""); err != nil {
+		return nil, err
+	} else if err := checkDevicesAllowed(nodeInfo, in.Configs.Devices); err != nil {
+		return nil, err
+	} else if err := ensureSeccompProfile(conn, in.Configs.SecurityConfig.GetSeccompProfile()); err != nil {
+		return nil, err
+	}
+
 	cfgs := model.ContainerConfigs{
 		NodeID:        nodeInfo.ID,
 		UUID:          uuid.New().String(),
 		ContainerName: in.Configs.Name,
+		Creator:       creator,
+
+		TemplateID:      in.Configs.TemplateId,
+		TemplateVersion: in.Configs.TemplateVersion,
 	}
 
 	if in.Configs.SecurityConfig != nil {
-		if !isEmptySecurityConfig(in.Configs.SecurityConfig) && common.NeedCheckAuth() && common.NeedCheckPerm() {
-			perms, _ := ctx.Value("PERMS").([]*user.Permission)
-			if !server.HasPerm(user.PERMISSION_CONTAINER_CONF_SEC, perms) {
-				return nil, rpc.ErrContainerSecurityConfigNoPerm
-			}
-		}
-
 		if data, err := json.Marshal(in.Configs.SecurityConfig); err != nil {
 			log.Warnf("Marshal SecurityConfig err: %v", err)
 			return nil, rpc.ErrInternal
@@ -148,7 +251,11 @@ func (s *ContainerServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb
 		return nil, rpc.ErrInternal
 	}
 
+	in.NodeId = nodeInfo.ID
 	in.Configs.Uuid = cfgs.UUID
+	in.Configs.Creator = creator
+	in.DeviceAllowlist = nodeAllowedDevices(nodeInfo)
+	setStoredConfigs(&cfgs, in.Configs)
 
 	cli := pb.NewContainerClient(conn)
 	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
@@ -165,9 +272,58 @@ func (s *ContainerServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb
 		log.Infof("Create: UpdateContainerConfigs data=%+v err=%v", cfgs, err)
 		// still return OK
 	}
+
+	agentReply.NodeId = nodeInfo.ID
 	return agentReply, nil
 }
 
+func (s *ContainerServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
+	if in.Configs == nil || in.NodeId < 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	// 模板信息只由CreateFromTemplate设置
+	in.Configs.TemplateId, in.Configs.TemplateVersion = 0, 0
+	return s.create(ctx, in)
+}
+
+func (s *ContainerServer) create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
+	if in.Configs == nil || in.NodeId < 0 {
+		return nil, rpc.ErrInvalidArgument
+	} else if err := checkCreateConfigs(ctx, in.Configs); err != nil {
+		return nil, err
+	}
+
+	// node_id为0时自动调度
+	var rejects []*pb.NodeScheduleInfo
+	if in.NodeId == 0 {
+		n, r, err := scheduleNode(in.Configs.ResouceLimit, in.Configs.Ports)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, rpc.ErrInternal
+		}
+		in.NodeId, rejects = n.ID, r
+	}
+
+	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	reply, err := createContainer(nodeInfo, in, getUserIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	reply.Rejects = rejects
+	return reply, nil
+}
+
 func (s *ContainerServer) Start(ctx context.Context, in *pb.StartRequest) (*pb.StartReply, error) {
 	reply := pb.StartReply{}
 
@@ -193,6 +349,12 @@ func (s *ContainerServer) Start(ctx context.Context, in *pb.StartRequest) (*pb.S
 			continue
 		}
 
+		containerIds, fails := filterAccessibleContainers(ctx, nodeInfo, containerIds)
+		reply.FailInfos = append(reply.FailInfos, fails...)
+		if len(containerIds) == 0 {
+			continue
+		}
+
 		conn, err := getAgentConn(nodeInfo.Address)
 		if err != nil {
 			log.Warnf("start container ErrInternal")
@@ -261,6 +423,12 @@ func (s *ContainerServer) Stop(ctx context.Context, in *pb.StopRequest) (*pb.Sto
 			continue
 		}
 
+		containerIds, fails := filterAccessibleContainers(ctx, nodeInfo, containerIds)
+		reply.FailInfos = append(reply.FailInfos, fails...)
+		if len(containerIds) == 0 {
+			continue
+		}
+
 		conn, err := getAgentConn(nodeInfo.Address)
 		if err != nil {
 			log.Warnf("stop container ErrInternal")
@@ -329,6 +497,12 @@ func (s *ContainerServer) Restart(ctx context.Context, in *pb.RestartRequest) (*
 			continue
 		}
 
+		containerIds, fails := filterAccessibleContainers(ctx, nodeInfo, containerIds)
+		reply.FailInfos = append(reply.FailInfos, fails...)
+		if len(containerIds) == 0 {
+			continue
+		}
+
 		conn, err := getAgentConn(nodeInfo.Address)
 		if err != nil {
 			log.Warnf("restart container ErrInternal")
@@ -397,6 +571,12 @@ func (s *ContainerServer) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb
 			continue
 		}
 
+		containerIds, fails := filterAccessibleContainers(ctx, nodeInfo, containerIds)
+		reply.FailInfos = append(reply.FailInfos, fails...)
+		if len(containerIds) == 0 {
+			continue
+		}
+
 		conn, err := getAgentConn(nodeInfo.Address)
 		if err != nil {
 			log.Warnf("remove container ErrInternal")
@@ -457,6 +637,10 @@ func (s *ContainerServer) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb
 		if err = model.RemoveContainerBackupByUUID(uuids); err != nil {
 			log.Warnf("remove container backup err: %v", err)
 		}
+
+		if err = model.RemoveBackupScheduleByUUID(uuids); err != nil {
+			log.Warnf("remove container backup schedule err: %v", err)
+		}
 	}
 
 	// 操作对象只有一个出错时确保返回错误码
@@ -471,11 +655,54 @@ func (s *ContainerServer) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb
 	return &reply, nil
 }
 
+// 合并控制器保存的安全配置和高可用配置
+func mergeStoredConfigs(nodeID int64, configs *pb.ContainerConfigs) {
+	if configs == nil {
+		return
+	}
+
+	data, err := model.GetContainerConfigs(nodeID, configs.ContainerId)
+	if err != nil {
+		log.Infof("db get container configs id=%v err=%v", configs.ContainerId, err)
+		return
+	}
+
+	var secCfgs pb.SecurityConfig
+	if err := json.Unmarshal([]byte(data.SecurityConfig), &secCfgs); err != nil {
+		log.Infof("unmarshal security config err=%v", err)
+	} else {
+		// 加固配置以容器实际生效的为准
+		h := configs.SecurityConfig
+		secCfgs.NoNewPrivileges = h.GetNoNewPrivileges()
+		secCfgs.ReadOnlyRootfs = h.GetReadOnlyRootfs()
+		secCfgs.SeccompProfile = h.GetSeccompProfile()
+		secCfgs.CapAdd = h.GetCapAdd()
+		secCfgs.CapDrop = h.GetCapDrop()
+		secCfgs.Tmpfs = h.GetTmpfs()
+		configs.SecurityConfig = &secCfgs
+	}
+
+	if configs.RestartPolicy != nil {
+		configs.RestartPolicy.Ha = data.HaEnabled
+	}
+
+	if configs.Creator == 0 {
+		configs.Creator = data.Creator
+	}
+	configs.SharedUsers = parseSharedUsers(data.SharedUsers)
+	configs.TemplateId = data.TemplateID
+	configs.TemplateVersion = data.TemplateVersion
+}
+
 func (s *ContainerServer) Inspect(ctx context.Context, in *pb.InspectRequest) (*pb.InspectReply, error) {
 	if in.NodeId <= 0 || in.ContainerId == "" {
 		return nil, rpc.ErrInvalidArgument
 	}
 
+	if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
+	}
+
 	nodeInfo, err := model.QueryNodeByID(in.NodeId)
 	if err != nil {
 		if err == model.ErrRecordNotFound {
@@ -498,25 +725,19 @@ func (s *ContainerServer) Inspect(ctx context.Context, in *pb.InspectRequest) (*
 		return nil, err
 	}
 
-	data, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
-	if err != nil {
-		log.Infof("db get container configs id=%v err=%v", in.ContainerId, err)
-	} else {
-		var secCfgs pb.SecurityConfig
-		if err := json.Unmarshal([]byte(data.SecurityConfig), &secCfgs); err != nil {
-			log.Infof("unmarshal security config err=%v", err)
-		} else {
-			if agentReply.Configs != nil {
-				agentReply.Configs.SecurityConfig = &secCfgs
-			}
-		}
-	}
+	mergeStoredConfigs(in.NodeId, agentReply.Configs)
 	return agentReply, nil
 }
 
 func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb.UpdateReply, error) {
 	if in.NodeId <= 0 || in.ContainerId == "" {
 		return nil, rpc.ErrInvalidArgument
+	} else if !isValidResourceLimit(in.ResourceLimit) {
+		return nil, status.Errorf(codes.InvalidArgument, "资源限制参数错误")
+	} else if !isValidNetworkBandwidth(in.Networks) {
+		return nil, status.Errorf(codes.InvalidArgument, "网络带宽限制参数错误")
+	} else if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
 	}
 
 	nodeInfo, err := model.QueryNodeByID(in.NodeId)
@@ -533,16 +754,29 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 	}
 
 	cli := pb.NewContainerClient(conn)
-	if common.NeedCheckAuth() && common.NeedCheckPerm() {
-		perms, _ := ctx.Value("PERMS").([]*user.Permission)
-
+	checkPerm := common.NeedCheckAuth() && common.NeedCheckPerm()
+	var inspect *pb.InspectReply
+	if len(in.Ports) > 0 || checkPerm {
 		ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
 		defer cancel()
-		inspect, err := cli.Inspect(ctx_, &pb.InspectRequest{ContainerId: in.ContainerId})
+		inspect, err = cli.Inspect(ctx_, &pb.InspectRequest{ContainerId: in.ContainerId})
 		if err != nil {
 			log.Warnf("Container.Update inspect container=%v err=%v", in.ContainerId, err)
 			return nil, err
 		}
+	}
+
+	// docker不支持修改已创建容器的端口映射, 需重新创建容器
+	if len(in.Ports) > 0 && !samePorts(inspect.Configs.GetPorts(), in.Ports) {
+		return nil, status.Errorf(codes.Unimplemented, "不支持修改端口映射, 请重新创建容器")
+	}
+
+	if checkPerm {
+		perms, _ := ctx.Value("PERMS").([]*user.Permission)
+
+		if cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId); err == nil && inspect.Configs.GetRestartPolicy() != nil {
+			inspect.Configs.RestartPolicy.Ha = cfgs.HaEnabled
+		}
 
 		if containerBasicConfigDiff(inspect.Configs, in) && !server.HasPerm(user.PERMISSION_CONTAINER_CONF_BASIC, perms) {
 			log.Infof("no permission to update container basic config")
@@ -573,23 +807,54 @@ func (s *ContainerServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb
 		return nil, err
 	}
 
-	if in.SecurityConfig != nil {
-		if data, err := json.Marshal(in.SecurityConfig); err != nil {
-			log.Warnf("Marshal SecurityConfig err: %v", err)
-		} else {
-			if containerConfigs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId); err == nil {
+	if containerConfigs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId); err == nil {
+		if in.SecurityConfig != nil {
+			if data, err := json.Marshal(in.SecurityConfig); err != nil {
+				log.Warnf("Marshal SecurityConfig err: %v", err)
+			} else {
 				containerConfigs.SecurityConfig = string(data)
-				if err := model.UpdateContainerConfigs(containerConfigs); err != nil {
-					log.Infof("Update: UpdateContainerConfigs node_id=%v container_id=%s err=%v", in.NodeId, in.ContainerId, err)
-				}
 			}
 		}
+
+		stored := getStoredConfigs(containerConfigs)
+		if stored == nil {
+			// 早期创建的容器未保存配置 从agent获取
+			ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			if r, err := cli.Inspect(ctx_, &pb.InspectRequest{ContainerId: in.ContainerId}); err == nil {
+				stored = r.Configs
+			}
+		}
+
+		if stored != nil {
+			if in.ResourceLimit != nil {
+				stored.ResouceLimit = in.ResourceLimit
+			}
+			if in.RestartPolicy != nil {
+				stored.RestartPolicy = in.RestartPolicy
+			}
+			if in.Networks != nil {
+				stored.Networks = in.Networks
+			}
+			setStoredConfigs(containerConfigs, stored)
+		}
+
+		if err := model.UpdateContainerConfigs(containerConfigs); err != nil {
+			log.Infof("Update: UpdateContainerConfigs node_id=%v container_id=%s err=%v", in.NodeId, in.ContainerId, err)
+		}
 	}
 
 	return agentReply, nil
 }
 
 func (s *ContainerServer) MonitorHistory(ctx context.Context, in *pb.MonitorHistoryRequest) (*pb.MonitorHistoryReply, error) {
+	// 未指定容器时查询节点总和信息
+	if in.ContainerId != "" {
+		if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+			return nil, err
+		}
+	}
+
 	nodeInfo, err := model.QueryNodeByID(in.NodeId)
 	if err != nil {
 		if err == model.ErrRecordNotFound {
@@ -615,6 +880,103 @@ func (s *ContainerServer) MonitorHistory(ctx context.Context, in *pb.MonitorHist
 	return agentReply, nil
 }
 
+// 容器已开启防篡改保护的文件列表
+func protectedFiles(cfgs *model.ContainerConfigs) []string {
+	if cfgs == nil || cfgs.SecurityConfig == "" {
+		return nil
+	}
+
+	var secCfg pb.SecurityConfig
+	if err := json.Unmarshal([]byte(cfgs.SecurityConfig), &secCfg); err != nil {
+		log.Infof("unmarshal security config err=%v", err)
+		return nil
+	} else if !secCfg.GetFileProtection().GetIsOn() {
+		return nil
+	}
+	return secCfg.FileProtection.FileList
+}
+
+func containerDiff(conn *grpc.ClientConn, nodeID int64, containerID string, fileList []string) (*pb.DiffReply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	reply, err := pb.NewContainerClient(conn).Diff(ctx, &pb.DiffRequest{NodeId: nodeID, ContainerId: containerID})
+	if err != nil {
+		return nil, err
+	}
+
+	reply.ProtectedChanged = markProtectedChanges(reply.Changes, fileList)
+	return reply, nil
+}
+
+func (s *ContainerServer) Diff(ctx context.Context, in *pb.DiffRequest) (*pb.DiffReply, error) {
+	if in.NodeId <= 0 || in.ContainerId == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+	if err != nil {
+		log.Infof("db get container configs id=%v err=%v", in.ContainerId, err)
+	}
+
+	reply, err := containerDiff(conn, in.NodeId, in.ContainerId, protectedFiles(cfgs))
+	if err != nil {
+		log.Warnf("container diff: %v", err)
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *ContainerServer) Share(ctx context.Context, in *pb.ShareRequest) (*pb.ShareReply, error) {
+	if in.NodeId <= 0 || in.ContainerId == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	userIDs := uniqueInt64(in.UserIds)
+	for _, id := range userIDs {
+		if id <= 0 {
+			return nil, rpc.ErrInvalidArgument
+		}
+	}
+
+	cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	// 受限用户只能共享自己创建的容器
+	if userID, ok := containerOwnerScope(ctx); ok && cfgs.Creator != userID {
+		return nil, rpc.ErrContainerNotOwned
+	}
+
+	cfgs.SharedUsers = formatSharedUsers(userIDs)
+	if err := model.UpdateContainerConfigs(cfgs); err != nil {
+		log.Infof("Share: UpdateContainerConfigs data=%+v err=%v", cfgs, err)
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.ShareReply{}, nil
+}
+
 func (s *ContainerServer) ListTemplate(ctx context.Context, in *pb.ListTemplateRequest) (*pb.ListTemplateReply, error) {
 	reply := &pb.ListTemplateReply{}
 
@@ -631,7 +993,13 @@ func (s *ContainerServer) ListTemplate(ctx context.Context, in *pb.ListTemplateR
 		// log.Println(template.Id, template.Name, template.Config_json)
 		var containerConfig *pb.ContainerConfigs
 		json.Unmarshal([]byte(template.ConfigJSON), &containerConfig)
-		templatestruct := pb.ContainerTemplate{Id: template.Id, Conf: containerConfig, NodeId: template.NodeId}
+		templatestruct := pb.ContainerTemplate{
+			Id:      template.Id,
+			Conf:    containerConfig,
+			NodeId:  template.NodeId,
+			Version: template.Version,
+			Params:  templateParams(template.ConfigJSON),
+		}
 		reply.Data = append(reply.Data, &templatestruct)
 	}
 
@@ -689,7 +1057,7 @@ func (s *ContainerServer) UpdateTemplate(ctx context.Context, in *pb.UpdateTempl
 	if err != nil {
 		return nil, err
 	}
-	err = model.UpdateTemplate(ctx, id, data.Conf.Name, confbyte, data.NodeId)
+	_, err = model.UpdateTemplate(ctx, id, data.Conf.Name, confbyte, data.NodeId)
 	if err != nil {
 		log.Println(err)
 	}
@@ -734,9 +1102,11 @@ func (*ContainerServer) InspectTemplate(ctx context.Context, in *pb.InspectTempl
 
 	reply := pb.InspectTemplateReply{
 		Data: &pb.ContainerTemplate{
-			Id:     data.Id,
-			Conf:   &c,
-			NodeId: data.NodeId,
+			Id:      data.Id,
+			Conf:    &c,
+			NodeId:  data.NodeId,
+			Version: data.Version,
+			Params:  templateParams(data.ConfigJSON),
 		},
 	}
 
@@ -751,15 +1121,64 @@ func (*ContainerServer) CreateBackup(ctx context.Context, in *pb.CreateBackupReq
 
 	if !isValidBackupDesc(in.BackupDesc) {
 		return nil, status.Errorf(codes.InvalidArgument, "参数错误：备份描述无效")
+	} else if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
 	}
 
-	configs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+	if _, err := createBackup(in.NodeId, in.ContainerId, in.BackupDesc, in.WithMounts, 0); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBackupReply{}, nil
+
+}
+
+// 挂载数据归档列表以JSON格式保存
+func setBackupArchives(b *model.ContainerBackup, archives []*pb.BackupArchive) {
+	b.Archives, b.ArchiveSize = "", 0
+	if len(archives) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(archives)
+	if err != nil {
+		log.Warnf("json marshal backup archives err=%v", err)
+		return
+	}
+	b.Archives = string(data)
+	for _, a := range archives {
+		b.ArchiveSize += a.Size
+	}
+}
+
+func getBackupArchives(b *model.ContainerBackup) []*pb.BackupArchive {
+	if b.Archives == "" {
+		return nil
+	}
+
+	var archives []*pb.BackupArchive
+	if err := json.Unmarshal([]byte(b.Archives), &archives); err != nil {
+		log.Infof("json unmarshal backup=%v archives err=%v", b.ID, err)
+		return nil
+	}
+	return archives
+}
+
+// 记录备份并通知agent执行备份任务, 任务结果由CheckContainerBackupJob更新
+// 备份名称使用创建时间 精确到毫秒
+func newBackupName() string {
+	now := time.Now()
+	return now.Format("20060102150405") + fmt.Sprintf("%d", now.Nanosecond()/1000000)
+}
+
+func createBackup(nodeID int64, containerID, backupDesc string, withMounts bool, scheduleID int64) (*model.ContainerBackup, error) {
+	configs, err := model.GetContainerConfigs(nodeID, containerID)
 	if err != nil {
 		log.Infof("model.GetContainerConfigs err=%v", err)
 		return nil, rpc.ErrInternal
 	}
 
-	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	nodeInfo, err := model.QueryNodeByID(nodeID)
 	if err != nil {
 		if err == model.ErrRecordNotFound {
 			return nil, rpc.ErrNotFound
@@ -772,9 +1191,8 @@ func (*ContainerServer) CreateBackup(ctx context.Context, in *pb.CreateBackupReq
 		return nil, rpc.ErrInternal
 	}
 
-	now := time.Now()
-	backupName := now.Format("20060102150405") + fmt.Sprintf("%d", now.Nanosecond()/1000000)
-	backup, err := model.CreateContainerBackup(in.NodeId, configs.UUID, backupName, in.BackupDesc)
+	backupName := newBackupName()
+	backup, err := model.CreateContainerBackup(nodeID, configs.UUID, backupName, backupDesc, scheduleID)
 	if err != nil {
 		return nil, rpc.ErrInternal
 	}
@@ -784,8 +1202,9 @@ func (*ContainerServer) CreateBackup(ctx context.Context, in *pb.CreateBackupReq
 	defer cancel()
 	_, err = cli.AddBackupJob(ctx_, &pb.AddBackupJobRequest{
 		Id:          backup.ID,
-		ContainerId: in.ContainerId,
+		ContainerId: containerID,
 		BackupName:  backupName,
+		WithMounts:  withMounts,
 	})
 	if err != nil {
 		// model set backup failed
@@ -796,8 +1215,7 @@ func (*ContainerServer) CreateBackup(ctx context.Context, in *pb.CreateBackupReq
 
 	model.UpdateContainerBackup(backup)
 
-	return &pb.CreateBackupReply{}, nil
-
+	return backup, nil
 }
 
 func (*ContainerServer) UpdateBackup(ctx context.Context, in *pb.UpdateBackupRequest) (*pb.UpdateBackupReply, error) {
@@ -812,6 +1230,8 @@ func (*ContainerServer) UpdateBackup(ctx context.Context, in *pb.UpdateBackupReq
 			return nil, rpc.ErrNotFound
 		}
 		return nil, rpc.ErrInternal
+	} else if err := checkBackupAccess(ctx, data); err != nil {
+		return nil, err
 	}
 
 	data.BackupDesc = in.BackupDesc
@@ -842,10 +1262,41 @@ func (*ContainerServer) RemoveBackup(ctx context.Context, in *pb.RemoveBackupReq
 		return nil, rpc.ErrInternal
 	}
 
+	if userID, ok := containerOwnerScope(ctx); ok && !canAccessContainer(cc, userID) {
+		return nil, rpc.ErrContainerNotOwned
+	}
+
 	if cc.NodeID != backup.NodeID {
 		return nil, rpc.ErrInternal
 	}
 
+	if err := removeBackup(backup, cc); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveBackupReply{}, nil
+}
+
+func (*ContainerServer) CancelBackupJob(ctx context.Context, in *pb.CancelBackupJobRequest) (*pb.CancelBackupJobReply, error) {
+	if in.Id <= 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	backup, err := model.QueryContainerBackupByID(in.Id)
+	if err != nil {
+		log.Infof("model.QueryContainerBackupByID err=%v", err)
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	} else if err := checkBackupAccess(ctx, backup); err != nil {
+		return nil, err
+	}
+
+	if backup.Status != int8(pb.BACKUP_STATUS_ONGOING) {
+		return nil, status.Errorf(codes.FailedPrecondition, "备份任务已结束")
+	}
+
 	nodeInfo, err := model.QueryNodeByID(backup.NodeID)
 	if err != nil {
 		if err == model.ErrRecordNotFound {
@@ -859,36 +1310,119 @@ func (*ContainerServer) RemoveBackup(ctx context.Context, in *pb.RemoveBackupReq
 		return nil, rpc.ErrInternal
 	}
 
+	// 执行中的任务由agent清理后推送结果
 	cli := pb.NewContainerClient(conn)
 	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
+	if _, err := cli.CancelBackupJob(ctx_, &pb.CancelBackupJobRequest{Id: backup.ID}); err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Warnf("agent CancelBackupJob id=%v err=%v", backup.ID, err)
+			return nil, err
+		}
 
-	ci, err := cli.Inspect(ctx_, &pb.InspectRequest{
-		NodeId:      backup.ID,
-		ContainerId: cc.ContainerID,
-	})
+		backup.Status = int8(pb.BACKUP_STATUS_CANCELED)
+		if err := model.UpdateContainerBackup(backup); err != nil {
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	return &pb.CancelBackupJobReply{}, nil
+}
+
+// 上传的备份可能与同节点上的其他备份使用相同的镜像
+func isBackupImageShared(backup *model.ContainerBackup) bool {
+	backups, err := model.QueryContainerBackupByUUID(backup.UUID)
 	if err != nil {
-		return nil, rpc.ErrInternal
+		return false
 	}
 
-	if ci.Configs.GetImage() == backup.ImageRef {
-		return nil, rpc.ErrRemoveContainerBackupWhenRunning
+	for _, b := range backups {
+		if b.ID != backup.ID && b.NodeID == backup.NodeID && b.ImageRef == backup.ImageRef {
+			return true
+		}
+	}
+	return false
+}
+
+// 删除节点上的备份镜像及挂载数据归档
+func removeNodeBackup(nodeInfo *model.NodeInfo, backup *model.ContainerBackup, cc *model.ContainerConfigs) error {
+	// 失败的备份没有生成镜像
+	if backup.ImageRef == "" && backup.Archives == "" {
+		return nil
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
 	}
 
-	if _, err := cli.RemoveBackup(ctx_, &pb.RemoveBackupRequest{
-		Id:       in.Id,
+	cli := pb.NewContainerClient(conn)
+	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// 容器迁移后 原节点上的备份镜像不再被使用
+	if cc != nil && cc.NodeID == backup.NodeID && cc.ContainerID != "" {
+		ci, err := cli.Inspect(ctx_, &pb.InspectRequest{
+			NodeId:      backup.NodeID,
+			ContainerId: cc.ContainerID,
+		})
+		if err != nil {
+			return rpc.ErrInternal
+		}
+
+		if ci.Configs.GetImage() == backup.ImageRef {
+			return rpc.ErrRemoveContainerBackupWhenRunning
+		}
+	}
+
+	request := pb.RemoveBackupRequest{
+		Id:       backup.ID,
 		ImageRef: backup.ImageRef,
-	}); err != nil {
+	}
+	if isBackupImageShared(backup) {
+		request.ImageRef = ""
+	}
+	if backup.Archives != "" {
+		request.BackupName = backup.BackupName
+	}
+	if _, err := cli.RemoveBackup(ctx_, &request); err != nil {
 		log.Warnf("agent RemoveBackup image=%v err=%v", backup.ImageRef, err)
-		return nil, rpc.ErrInternal
+		return rpc.ErrInternal
+	}
+	return nil
+}
+
+// 删除备份镜像及记录 备份镜像正被容器使用时不能删除
+func removeBackup(backup *model.ContainerBackup, cc *model.ContainerConfigs) error {
+	if backup.Status == int8(pb.BACKUP_STATUS_ONGOING) {
+		return status.Errorf(codes.FailedPrecondition, "备份进行中, 请先取消备份")
+	}
+
+	// 节点已删除时只删除备份记录
+	nodeInfo, err := model.QueryNodeByID(backup.NodeID)
+	if err == model.ErrRecordNotFound {
+		log.Infof("node=%v of backup=%v not found, remove record only", backup.NodeID, backup.ID)
+	} else if err != nil {
+		return rpc.ErrInternal
+	} else if err := removeNodeBackup(nodeInfo, backup, cc); err != nil {
+		return err
 	}
 
 	if err := model.RemoveContainerBackup(backup); err != nil {
 		log.Warnf("db remove backup record err=%v", err)
-		return nil, rpc.ErrInternal
+		return rpc.ErrInternal
 	}
 
-	return &pb.RemoveBackupReply{}, nil
+	// 在其他节点恢复后的备份共用仓库镜像
+	if backup.RegistryRef != "" {
+		if cnt, err := model.CountContainerBackupByRegistryRef(backup.RegistryRef); err == nil && cnt == 0 {
+			if err := model.RemoveRegistryImage(backup.RegistryRef); err != nil {
+				log.Infof("registry remove backup image=%v err=%v", backup.RegistryRef, err)
+			}
+		}
+	}
+
+	return nil
 }
 
 func (*ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupRequest) (*pb.ResumeBackupReply, error) {
@@ -912,11 +1446,24 @@ func (*ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupReq
 		return nil, rpc.ErrInternal
 	}
 
+	if userID, ok := containerOwnerScope(ctx); ok && !canAccessContainer(cfgs, userID) {
+		return nil, rpc.ErrContainerNotOwned
+	}
+
 	var secCfg pb.SecurityConfig
 	if err := json.Unmarshal([]byte(cfgs.SecurityConfig), &secCfg); err != nil {
 		log.Infof("json unmarshal security config err=%v", err)
 	}
 
+	targetNodeID := in.TargetNodeId
+	if targetNodeID == 0 {
+		targetNodeID = backup.NodeID
+	}
+	// 上传的备份没有对应的容器
+	if targetNodeID != backup.NodeID || cfgs.NodeID != backup.NodeID || cfgs.ContainerID == "" {
+		return resumeBackupOnNode(backup, cfgs, &secCfg, targetNodeID)
+	}
+
 	nodeInfo, err := model.QueryNodeByID(backup.NodeID)
 	if err != nil {
 		if err == model.ErrRecordNotFound {
@@ -934,9 +1481,11 @@ func (*ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupReq
 	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 	subReply, err := cli.ResumeBackup(ctx_, &pb.ResumeBackupRequest{
-		ContainerId:    in.ContainerId,
-		ImageRef:       backup.ImageRef,
-		SecurityConfig: &secCfg,
+		ContainerId:     in.ContainerId,
+		ImageRef:        backup.ImageRef,
+		SecurityConfig:  &secCfg,
+		Archives:        getBackupArchives(backup),
+		DeviceAllowlist: nodeAllowedDevices(nodeInfo),
 	})
 	if err != nil {
 		log.Warnf("agent ResumeBackup failed err=%v", err)
@@ -944,11 +1493,368 @@ func (*ContainerServer) ResumeBackup(ctx context.Context, in *pb.ResumeBackupReq
 	}
 
 	cfgs.ContainerID = subReply.ContainerId
+	if stored := getStoredConfigs(cfgs); stored != nil {
+		stored.Image = backup.ImageRef
+		setStoredConfigs(cfgs, stored)
+	}
 	model.UpdateContainerConfigs(cfgs)
 
+	if len(subReply.SkippedArchives) > 0 {
+		return nil, status.Errorf(codes.DataLoss, "容器已恢复, 挂载点%v数据恢复失败", strings.Join(subReply.SkippedArchives, ","))
+	}
+
+	subReply.NodeId = nodeInfo.ID
 	return subReply, nil
 }
 
+// 停止并删除节点上的原容器, 节点不可用时跳过
+func removeNodeContainer(nodeID int64, containerID string) error {
+	if containerID == "" {
+		return nil
+	}
+
+	nodeInfo, err := model.QueryNodeByID(nodeID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return err
+	}
+
+	cli := pb.NewContainerClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ids := []*pb.ContainerIdList{{ContainerIds: []string{containerID}}}
+	if _, err := cli.Stop(ctx, &pb.StopRequest{Ids: ids}); err != nil {
+		log.Infof("stop container=%v on node=%v err=%v", containerID, nodeInfo.Address, err)
+	}
+	removeReply, err := cli.Remove(ctx, &pb.RemoveRequest{Ids: ids})
+	if err == nil && len(removeReply.FailInfos) > 0 {
+		err = status.Error(codes.Internal, removeReply.FailInfos[0].FailReason)
+	}
+	return err
+}
+
+// 使用原容器配置在目标节点上恢复备份, 非备份所在节点从仓库拉取备份镜像
+func resumeBackupOnNode(backup *model.ContainerBackup, cfgs *model.ContainerConfigs, secCfg *pb.SecurityConfig, targetNodeID int64) (*pb.ResumeBackupReply, error) {
+	if targetNodeID != backup.NodeID && backup.RegistryRef == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "备份镜像未推送至仓库, 只能在备份所在节点恢复")
+	}
+
+	configs := getStoredConfigs(cfgs)
+	if configs == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "缺少容器配置, 无法在其他节点恢复")
+	}
+	configs.Uuid = cfgs.UUID
+	configs.SecurityConfig = secCfg
+
+	dstNode, err := model.QueryNodeByID(targetNodeID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "目标节点不存在")
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	dstConn, err := getAgentConn(dstNode.Address)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	// 原容器在目标节点上时由agent在镜像就绪后、创建新容器前删除
+	oldNodeID, oldContainerID := cfgs.NodeID, cfgs.ContainerID
+	var replaceID string
+	if oldNodeID == dstNode.ID {
+		replaceID, oldContainerID = oldContainerID, ""
+	}
+
+	if err := checkPortConflict(dstConn, configs.Ports, replaceID); err != nil {
+		return nil, err
+	} else if err := checkDevicesAllowed(dstNode, configs.Devices); err != nil {
+		return nil, err
+	} else if err := ensureSeccompProfile(dstConn, secCfg.SeccompProfile); err != nil {
+		return nil, err
+	}
+
+	// 挂载数据归档只保存在备份所在节点, 其他节点上恢复时在结果中标记未恢复的挂载点
+	var archives, skipped []*pb.BackupArchive
+	if dstNode.ID == backup.NodeID {
+		archives = getBackupArchives(backup)
+	} else {
+		skipped = getBackupArchives(backup)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	subReply, err := pb.NewContainerClient(dstConn).ResumeBackup(ctx, &pb.ResumeBackupRequest{
+		ImageRef:       backup.ImageRef,
+		RegistryRef:    backup.RegistryRef,
+		SecurityConfig: secCfg,
+		Configs:        configs,
+		Archives:       archives,
+
+		ReplaceContainerId: replaceID,
+		DeviceAllowlist:    nodeAllowedDevices(dstNode),
+	})
+	if err != nil {
+		log.Warnf("agent ResumeBackup on node=%v err=%v", dstNode.Address, err)
+		return nil, err
+	}
+
+	// 原节点故障时可能无法删除 不影响恢复结果
+	if err := removeNodeContainer(oldNodeID, oldContainerID); err != nil {
+		log.Infof("remove container=%v on node=%v err=%v", oldContainerID, oldNodeID, err)
+	}
+
+	cfgs.NodeID = dstNode.ID
+	cfgs.ContainerID = subReply.ContainerId
+	configs.Image = backup.ImageRef
+	setStoredConfigs(cfgs, configs)
+	if err := model.UpdateContainerConfigs(cfgs); err != nil {
+		log.Warnf("ResumeBackup: UpdateContainerConfigs data=%+v err=%v", cfgs, err)
+		return nil, rpc.ErrInternal
+	}
+
+	// 目标节点上拉取的镜像记录为备份点, 避免被识别为非法镜像
+	if dstNode.ID != backup.NodeID && !hasNodeBackupImage(dstNode.ID, backup) {
+		name := fmt.Sprintf("%s-%d", backup.BackupName, dstNode.ID)
+		copied, err := model.CreateContainerBackup(dstNode.ID, cfgs.UUID, name, backup.BackupDesc, 0)
+		if err != nil {
+			log.Warnf("ResumeBackup: CreateContainerBackup uuid=%v err=%v", cfgs.UUID, err)
+		} else {
+			copied.ContainerID = subReply.ContainerId
+			copied.ImageRef = backup.ImageRef
+			copied.ImageID = backup.ImageID
+			copied.ImageSize = backup.ImageSize
+			copied.RegistryRef = backup.RegistryRef
+			copied.Status = int8(pb.BACKUP_STATUS_SUCCEED)
+			if err := model.UpdateContainerBackup(copied); err != nil {
+				log.Warnf("ResumeBackup: UpdateContainerBackup id=%v err=%v", copied.ID, err)
+			}
+		}
+	}
+
+	if len(subReply.SkippedArchives) > 0 {
+		return nil, status.Errorf(codes.DataLoss, "容器已恢复, 挂载点%v数据恢复失败", strings.Join(subReply.SkippedArchives, ","))
+	}
+
+	reply := pb.ResumeBackupReply{ContainerId: subReply.ContainerId, NodeId: dstNode.ID}
+	for _, a := range skipped {
+		log.Infof("backup=%v archive=%v skipped on node=%v", backup.ID, a.Destination, dstNode.Address)
+		reply.SkippedArchives = append(reply.SkippedArchives, a.Destination)
+	}
+	return &reply, nil
+}
+
+func hasNodeBackupImage(nodeID int64, backup *model.ContainerBackup) bool {
+	backups, err := model.QueryContainerBackupByUUID(backup.UUID)
+	if err != nil {
+		return false
+	}
+	for _, b := range backups {
+		if b.NodeID == nodeID && b.ImageRef == backup.ImageRef {
+			return true
+		}
+	}
+	return false
+}
+
+// 提交镜像/推送/拉取耗时较长
+const migrateTimeout = time.Minute * 10
+
+func (*ContainerServer) Migrate(ctx context.Context, in *pb.MigrateRequest) (*pb.MigrateReply, error) {
+	if in.NodeId <= 0 || in.ContainerId == "" || in.TargetNodeId <= 0 || in.NodeId == in.TargetNodeId {
+		log.Infof("Migrate invalid input: %+v", in)
+		return nil, rpc.ErrInvalidArgument
+	} else if err := checkContainerAccess(ctx, in.NodeId, in.ContainerId); err != nil {
+		return nil, err
+	}
+
+	cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+	if err != nil {
+		log.Infof("model.GetContainerConfigs err=%v", err)
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	var secCfg pb.SecurityConfig
+	if cfgs.SecurityConfig != "" {
+		if err := json.Unmarshal([]byte(cfgs.SecurityConfig), &secCfg); err != nil {
+			log.Infof("json unmarshal security config err=%v", err)
+			return nil, rpc.ErrInternal
+		}
+	}
+
+	srcNode, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	dstNode, err := model.QueryNodeByID(in.TargetNodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "目标节点不存在")
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	srcConn, err := getAgentConn(srcNode.Address)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	dstConn, err := getAgentConn(dstNode.Address)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	srcCli, dstCli := pb.NewContainerClient(srcConn), pb.NewContainerClient(dstConn)
+
+	// 源节点提交容器为镜像并推送至registry
+	ctx_, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	// 同一秒内多次迁移时tag不能重复
+	now := time.Now()
+	tag := fmt.Sprintf("migrate%s%09d", now.Format("20060102150405"), now.Nanosecond())
+	commitReply, err := srcCli.CommitImage(ctx_, &pb.CommitImageRequest{
+		ContainerId: in.ContainerId,
+		Tag:         tag,
+	})
+	if err != nil {
+		log.Warnf("agent CommitImage container=%v err=%v", in.ContainerId, err)
+		return nil, err
+	}
+
+	// 迁移失败时删除源节点提交的镜像及仓库中的镜像
+	success := false
+	defer func() {
+		if !success {
+			removeMigrateImage(srcCli, commitReply.ImageRef)
+		}
+	}()
+
+	// 目标节点使用相同配置/UUID/安全配置创建容器
+	configs := commitReply.Configs
+	configs.Image = commitReply.ImageRef
+	configs.Uuid = cfgs.UUID
+	configs.SecurityConfig = &secCfg
+	if err := checkPortConflict(dstConn, configs.Ports, ""); err != nil {
+		return nil, err
+	} else if err := checkDevicesAllowed(dstNode, configs.Devices); err != nil {
+		return nil, err
+	} else if err := ensureSeccompProfile(dstConn, secCfg.SeccompProfile); err != nil {
+		return nil, err
+	}
+	// 删除目标节点拉取的迁移镜像
+	removeDstImage := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		if _, e := dstCli.RemoveBackup(ctx, &pb.RemoveBackupRequest{ImageRef: commitReply.ImageRef}); e != nil {
+			log.Infof("remove migrate image=%v on node=%v err=%v", commitReply.ImageRef, dstNode.Address, e)
+		}
+	}
+
+	migrateReply, err := dstCli.Migrate(ctx_, &pb.MigrateRequest{Configs: configs, DeviceAllowlist: nodeAllowedDevices(dstNode)})
+	if err != nil {
+		log.Warnf("agent Migrate to node=%v err=%v", dstNode.Address, err)
+		removeDstImage()
+		return nil, err
+	}
+
+	// 回滚目标节点上已创建的容器及拉取的镜像
+	rollback := func() {
+		newIds := []*pb.ContainerIdList{{ContainerIds: []string{migrateReply.ContainerId}}}
+		if _, e := dstCli.Remove(ctx_, &pb.RemoveRequest{Ids: newIds}); e != nil {
+			log.Warnf("rollback remove container=%v on node=%v err=%v", migrateReply.ContainerId, dstNode.Address, e)
+			return
+		}
+		removeDstImage()
+	}
+
+	// 停止并删除源容器
+	ids := []*pb.ContainerIdList{{ContainerIds: []string{in.ContainerId}}}
+	stopReply, err := srcCli.Stop(ctx_, &pb.StopRequest{Ids: ids})
+	if err == nil && len(stopReply.FailInfos) > 0 {
+		err = status.Error(codes.Internal, stopReply.FailInfos[0].FailReason)
+	}
+	if err != nil {
+		log.Warnf("stop source container=%v err=%v", in.ContainerId, err)
+		rollback()
+		return nil, status.Errorf(codes.Internal, "停止源容器失败")
+	}
+
+	// 源容器删除失败时保留源容器, 避免两个节点上存在相同UUID的容器
+	removeReply, err := srcCli.Remove(ctx_, &pb.RemoveRequest{Ids: ids})
+	if err == nil && len(removeReply.FailInfos) > 0 {
+		err = status.Error(codes.Internal, removeReply.FailInfos[0].FailReason)
+	}
+	if err != nil {
+		log.Warnf("remove source container=%v err=%v", in.ContainerId, err)
+		rollback()
+		if configs.Status == "running" {
+			if _, e := srcCli.Start(ctx_, &pb.StartRequest{Ids: ids}); e != nil {
+				log.Warnf("restart source container=%v err=%v", in.ContainerId, e)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "删除源容器失败")
+	}
+
+	if configs.RestartPolicy != nil {
+		configs.RestartPolicy.Ha = cfgs.HaEnabled
+	}
+	cfgs.NodeID = dstNode.ID
+	cfgs.ContainerID = migrateReply.ContainerId
+	setStoredConfigs(cfgs, configs)
+	if err := model.UpdateContainerConfigs(cfgs); err != nil {
+		log.Warnf("Migrate: UpdateContainerConfigs data=%+v err=%v", cfgs, err)
+		return nil, rpc.ErrInternal
+	}
+	success = true
+
+	// 迁移镜像记录为目标节点上的备份点, 避免被识别为非法镜像
+	backup, err := model.CreateContainerBackup(dstNode.ID, cfgs.UUID, tag, "容器迁移", 0)
+	if err != nil {
+		log.Warnf("Migrate: CreateContainerBackup uuid=%v err=%v", cfgs.UUID, err)
+	} else {
+		backup.ContainerID = migrateReply.ContainerId
+		backup.ImageRef = commitReply.ImageRef
+		backup.ImageID = commitReply.ImageId
+		backup.ImageSize = commitReply.ImageSize
+		backup.Status = int8(pb.BACKUP_STATUS_SUCCEED)
+		if err := model.UpdateContainerBackup(backup); err != nil {
+			log.Warnf("Migrate: UpdateContainerBackup id=%v err=%v", backup.ID, err)
+		}
+	}
+
+	if configs.Status == "running" {
+		newIds := []*pb.ContainerIdList{{ContainerIds: []string{migrateReply.ContainerId}}}
+		if _, err := dstCli.Start(ctx_, &pb.StartRequest{Ids: newIds}); err != nil {
+			log.Warnf("start container=%v on node=%v err=%v", migrateReply.ContainerId, dstNode.Address, err)
+		}
+	}
+
+	return &pb.MigrateReply{NodeId: dstNode.ID, ContainerId: migrateReply.ContainerId}, nil
+}
+
+// 删除迁移时源节点提交的镜像及推送至仓库的镜像
+func removeMigrateImage(srcCli pb.ContainerClient, imageRef string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	if _, err := srcCli.RemoveBackup(ctx, &pb.RemoveBackupRequest{ImageRef: imageRef}); err != nil {
+		log.Warnf("remove migrate image=%v on source node err=%v", imageRef, err)
+	}
+	if err := model.RemovePushedLocalImage(imageRef); err != nil {
+		log.Warnf("registry remove migrate image=%v err=%v", imageRef, err)
+	}
+}
+
 func (*ContainerServer) ListBackup(ctx context.Context, in *pb.ListBackupRequest) (*pb.ListBackupReply, error) {
 	configs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
 	if err != nil {
@@ -956,6 +1862,10 @@ func (*ContainerServer) ListBackup(ctx context.Context, in *pb.ListBackupRequest
 		return nil, rpc.ErrInternal
 	}
 
+	if userID, ok := containerOwnerScope(ctx); ok && !canAccessContainer(configs, userID) {
+		return nil, rpc.ErrContainerNotOwned
+	}
+
 	data, err := model.QueryContainerBackupByUUID(configs.UUID)
 	if err != nil {
 		log.Infof("model.QueryContainerBackupByUUID err=%v", err)
@@ -963,18 +1873,231 @@ func (*ContainerServer) ListBackup(ctx context.Context, in *pb.ListBackupRequest
 	}
 
 	var reply pb.ListBackupReply
+	jobs := backupJobsProgress(data)
 	for _, b := range data {
+		var progress, total int64
+		if job, ok := jobs[b.ID]; ok {
+			progress, total = job.Progress, job.Total
+		}
+
 		reply.Data = append(reply.Data, &pb.ContainerBackup{
-			Id:         b.ID,
-			Uuid:       b.UUID,
-			BackupName: b.BackupName,
-			BackupDesc: b.BackupDesc,
-			ImageRef:   b.ImageRef,
-			ImageSize:  b.ImageSize,
-			Status:     int64(b.Status),
-			CreatedAt:  b.CreatedAt,
+			Id:          b.ID,
+			Uuid:        b.UUID,
+			BackupName:  b.BackupName,
+			BackupDesc:  b.BackupDesc,
+			ImageRef:    b.ImageRef,
+			ImageSize:   b.ImageSize,
+			Status:      int64(b.Status),
+			RegistryRef: b.RegistryRef,
+			ArchiveSize: b.ArchiveSize,
+			Archives:    getBackupArchives(b),
+			Progress:    progress,
+			Total:       total,
+			CreatedAt:   b.CreatedAt,
 		})
 	}
 
 	return &reply, nil
 }
+
+// 按节点批量查询agent上进行中备份任务的进度
+func backupJobsProgress(backups []*model.ContainerBackup) map[int64]*pb.GetBackupJobReply {
+	nodeJobs := make(map[int64][]int64)
+	for _, b := range backups {
+		if b.Status == int8(pb.BACKUP_STATUS_ONGOING) {
+			nodeJobs[b.NodeID] = append(nodeJobs[b.NodeID], b.ID)
+		}
+	}
+
+	jobs := make(map[int64]*pb.GetBackupJobReply)
+	for nodeID, ids := range nodeJobs {
+		nodeInfo, err := model.QueryNodeByID(nodeID)
+		if err != nil {
+			continue
+		}
+
+		conn, err := getAgentConn(nodeInfo.Address)
+		if err != nil {
+			continue
+		}
+
+		rep, err := ListBackupJob(conn, ids)
+		if err != nil {
+			log.Infof("ListBackupJob node=%v err=%v", nodeInfo.Address, err)
+			continue
+		}
+		for _, job := range rep.Jobs {
+			jobs[job.Id] = job
+		}
+	}
+	return jobs
+}
+
+func (*ContainerServer) Exec(stream pb.Container_ExecServer) error {
+	in, err := stream.Recv()
+	if err != nil {
+		log.Infof("Exec receive first request err=%v", err)
+		return rpc.ErrInvalidArgument
+	}
+
+	var exitCode int32 = -1
+	err = execContainer(stream, in, &exitCode)
+	logData := server.RuntimeLogWritter{}.ExecContainer(in, exitCode)
+	if nodeInfo, e := model.QueryNodeByID(in.NodeId); e == nil {
+		logData.NodeInfo = fmt.Sprintf("%s (%s)", nodeInfo.Name, nodeInfo.Address)
+	}
+	server.SaveRuntimeLog(stream.Context(), logData, err)
+	return err
+}
+
+func execContainer(stream pb.Container_ExecServer, in *pb.ExecRequest, exitCode *int32) error {
+	if in.NodeId <= 0 || in.ContainerId == "" {
+		log.Infof("Exec invalid input: %+v", in)
+		return rpc.ErrInvalidArgument
+	}
+
+	if err := checkContainerAccess(stream.Context(), in.NodeId, in.ContainerId); err != nil {
+		return err
+	}
+
+	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+
+	// 禁止命令行控制的容器 仅允许安全管理员执行命令
+	if common.NeedCheckAuth() && common.NeedCheckPerm() {
+		// 无配置记录的容器未经控制器创建, 没有加固配置
+		cfgs, err := model.GetContainerConfigs(in.NodeId, in.ContainerId)
+		if err != nil && err != model.ErrRecordNotFound {
+			log.Warnf("db get container configs id=%v err=%v", in.ContainerId, err)
+			return rpc.ErrInternal
+		} else if err == nil && cfgs.SecurityConfig != "" {
+			var secCfgs pb.SecurityConfig
+			if err := json.Unmarshal([]byte(cfgs.SecurityConfig), &secCfgs); err != nil {
+				log.Warnf("unmarshal security config container=%v err=%v", in.ContainerId, err)
+				return rpc.ErrInternal
+			} else if secCfgs.DisableCmdOperation {
+				perms, _ := stream.Context().Value("PERMS").([]*user.Permission)
+				if !server.HasPerm(user.PERMISSION_CONTAINER_CONF_SEC, perms) {
+					log.Infof("no permission to exec in container=%v", in.ContainerId)
+					return rpc.ErrContainerExecNoPerm
+				}
+			}
+		}
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cli := pb.NewContainerClient(conn)
+	agentStream, err := cli.Exec(ctx)
+	if err != nil {
+		log.Warnf("Exec open agent stream err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	if err := agentStream.Send(in); err != nil {
+		log.Warnf("Exec send to agent err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	// 客户端输入转发至agent
+	go func() {
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				agentStream.CloseSend()
+				return
+			} else if err != nil {
+				log.Infof("Exec receive from client err=%v", err)
+				cancel()
+				return
+			}
+
+			if err := agentStream.Send(r); err != nil {
+				log.Infof("Exec send to agent err=%v", err)
+				cancel()
+				return
+			}
+		}
+	}()
+
+	// agent输出转发至客户端
+	for {
+		r, err := agentStream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Infof("Exec receive from agent err=%v", err)
+			return err
+		}
+
+		if r.Exited {
+			*exitCode = r.ExitCode
+		}
+
+		if err := stream.Send(r); err != nil {
+			log.Infof("Exec send to client err=%v", err)
+			return err
+		}
+	}
+}
+
+func (*ContainerServer) Logs(in *pb.LogsRequest, stream pb.Container_LogsServer) error {
+	if in.NodeId <= 0 || in.ContainerId == "" || in.Tail < 0 {
+		log.Infof("Logs invalid input: %+v", in)
+		return rpc.ErrInvalidArgument
+	}
+
+	if err := checkContainerAccess(stream.Context(), in.NodeId, in.ContainerId); err != nil {
+		return err
+	}
+
+	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	// 客户端断开时结束agent侧的日志读取
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	cli := pb.NewContainerClient(conn)
+	agentStream, err := cli.Logs(ctx, in)
+	if err != nil {
+		log.Warnf("Logs open agent stream err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	for {
+		r, err := agentStream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Infof("Logs receive from agent err=%v", err)
+			return err
+		}
+
+		if err := stream.Send(r); err != nil {
+			log.Infof("Logs send to client err=%v", err)
+			return err
+		}
+	}
+}
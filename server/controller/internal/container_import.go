@@ -0,0 +1,354 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+)
+
+// 镜像同步至节点前创建容器会失败, 超过该次数后放弃
+const maxImportAttempts = 10
+
+func (*ContainerServer) Export(in *pb.ExportRequest, stream pb.Container_ExportServer) error {
+	if in.NodeId <= 0 || in.ContainerId == "" {
+		return rpc.ErrInvalidArgument
+	}
+
+	if err := checkContainerAccess(stream.Context(), in.NodeId, in.ContainerId); err != nil {
+		return err
+	}
+
+	nodeInfo, err := model.QueryNodeByID(in.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return rpc.ErrInternal
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	cli := pb.NewContainerClient(conn)
+	agentStream, err := cli.Export(ctx, in)
+	if err != nil {
+		log.Warnf("Export open agent stream err=%v", err)
+		return rpc.ErrInternal
+	}
+
+	for {
+		r, err := agentStream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Infof("Export receive from agent err=%v", err)
+			return err
+		}
+
+		if r.Info != nil {
+			mergeStoredConfigs(in.NodeId, r.Info.Configs)
+		}
+
+		if err := stream.Send(r); err != nil {
+			log.Infof("Export send to client err=%v", err)
+			return err
+		}
+	}
+}
+
+func receiveImportSign(stream pb.Container_ImportServer, req *pb.ImportRequest, signFile string) error {
+	file, err := os.Create(signFile)
+	if err != nil {
+		log.Errorf("cannot create file %v: %v", signFile, err)
+		return rpc.ErrInternal
+	}
+	defer file.Close()
+
+	r, err := stream.Recv()
+	if err != nil {
+		log.Errorf("recv sign err: %v", err)
+		return err
+	}
+
+	if req.Sign.Size != int64(len(r.ChunkData)) {
+		log.Errorf("recv sign size err, send[%v] recv:[%v]", req.Sign.Size, len(r.ChunkData))
+		return rpc.ErrInvalidArgument
+	}
+
+	if _, err := file.Write(r.ChunkData); err != nil {
+		log.Errorf("cannot write chunk data to file: %v", err)
+		return rpc.ErrInternal
+	}
+	return nil
+}
+
+func receiveImportData(stream pb.Container_ImportServer, req *pb.ImportRequest, dataFile string) error {
+	file, err := os.Create(dataFile)
+	if err != nil {
+		log.Errorf("cannot create file %v: %v", dataFile, err)
+		return rpc.ErrInternal
+	}
+	defer file.Close()
+
+	var size int64
+	for {
+		if err := contextError(stream.Context()); err != nil {
+			return err
+		}
+
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Errorf("cannot receive chunk data: %v", err)
+			return rpc.ErrUnknown
+		}
+
+		size += int64(len(r.ChunkData))
+		if size > maxImageSize || size > req.Info.Size {
+			log.Errorf("import data is too large: [%v] > [%v] || [%v]", size, maxImageSize, req.Info.Size)
+			return rpc.ErrInvalidArgument
+		}
+
+		if _, err := file.Write(r.ChunkData); err != nil {
+			log.Errorf("cannot write chunk data to file: %v", err)
+			return rpc.ErrInternal
+		}
+	}
+}
+
+func (*ContainerServer) Import(stream pb.Container_ImportServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		log.Errorf("cannot receive import info %v", err)
+		return rpc.ErrUnknown
+	}
+
+	info := req.Info
+	if info == nil || req.Sign == nil || info.Configs == nil || info.NodeId <= 0 {
+		return rpc.ErrInvalidArgument
+	} else if !isValidImageName(info.ImageName) {
+		return status.Errorf(codes.InvalidArgument, "镜像名参数错误")
+	} else if !isValidImageVersion(info.ImageVersion) {
+		return status.Errorf(codes.InvalidArgument, "镜像版本参数错误")
+	} else if !isValidImageDesc(info.ImageDesc) {
+		return status.Errorf(codes.InvalidArgument, "镜像描述参数错误")
+	} else if err := checkCreateConfigs(stream.Context(), info.Configs); err != nil {
+		return err
+	}
+
+	nodeInfo, err := model.QueryNodeByID(info.NodeId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrNotFound
+		}
+		return rpc.ErrInternal
+	} else if err := checkDevicesAllowed(nodeInfo, info.Configs.Devices); err != nil {
+		return err
+	}
+
+	// 先写入临时文件, 镜像记录创建成功后再改名, 避免覆盖同名镜像的文件
+	var staged []string
+	defer func() {
+		for _, f := range staged {
+			os.Remove(f)
+		}
+	}()
+	for _, ext := range []string{".sign", ".rootfs", ".tar"} {
+		f, err := ioutil.TempFile(imageDir(), ".import-*"+ext)
+		if err != nil {
+			log.Errorf("create temp file err=%v", err)
+			return rpc.ErrInternal
+		}
+		f.Close()
+		staged = append(staged, f.Name())
+	}
+	signTemp, rootfsFile, tarTemp := staged[0], staged[1], staged[2]
+
+	if err := receiveImportSign(stream, req, signTemp); err != nil {
+		return err
+	}
+
+	// 签名针对客户端上传的容器导出数据
+	if err := receiveImportData(stream, req, rootfsFile); err != nil {
+		return err
+	}
+	verifyStatus := signVerify(signTemp, rootfsFile)
+
+	imageConfig := model.ImageRuntimeConfig{
+		Cmd:        info.ImageConfig.GetCmd(),
+		Entrypoint: info.ImageConfig.GetEntrypoint(),
+		Env:        info.ImageConfig.GetEnv(),
+		WorkingDir: info.ImageConfig.GetWorkingDir(),
+		User:       info.ImageConfig.GetUser(),
+	}
+	imageRef := info.ImageName + ":" + info.ImageVersion
+	imageID, err := model.BuildImageArchive(rootfsFile, tarTemp, imageRef, imageConfig)
+	if err != nil {
+		log.Warnf("build image archive file=%v err=%v", tarTemp, err)
+		return rpc.ErrInternal
+	}
+
+	checkSum, fileSize, err := model.FileSha256(tarTemp)
+	if err != nil {
+		log.Warnf("hash image file=%v err=%v", tarTemp, err)
+		return rpc.ErrInternal
+	}
+
+	fileName := fmt.Sprintf("%s/%s_%s.tar", imageDir(), info.ImageName, info.ImageVersion)
+	signFile := fmt.Sprintf("%s/%s_%s.sign", imageDir(), info.ImageName, info.ImageVersion)
+	id, err := model.CreateImages(model.ImageInfo{
+		Name:         info.ImageName,
+		Version:      info.ImageVersion,
+		Description:  info.ImageDesc,
+		FileSize:     fileSize,
+		FileType:     ".tar",
+		CheckSum:     checkSum,
+		ImageId:      imageID,
+		FilePath:     fileName,
+		SignPath:     signFile,
+		VerifyStatus: verifyStatus,
+	})
+	if err != nil {
+		if err == model.ErrDuplicateKey {
+			return status.Errorf(codes.AlreadyExists, "镜像%s已存在", imageRef)
+		}
+		return rpc.ErrInternal
+	}
+
+	// 失败时删除镜像记录及已改名的文件
+	removeImage := func() {
+		if err := model.RemoveImages([]int64{id}); err != nil {
+			log.Warnf("remove image id=%v err=%v", id, err)
+		}
+		os.Remove(fileName)
+		os.Remove(signFile)
+	}
+
+	if err = os.Rename(tarTemp, fileName); err != nil {
+		log.Errorf("rename %v to %v err=%v", tarTemp, fileName, err)
+	} else if err = os.Rename(signTemp, signFile); err != nil {
+		log.Errorf("rename %v to %v err=%v", signTemp, signFile, err)
+	}
+	if err != nil {
+		removeImage()
+		return rpc.ErrInternal
+	}
+
+	info.Configs.Image = imageRef
+	data, err := json.Marshal(info.Configs)
+	if err != nil {
+		log.Warnf("Marshal ContainerConfigs err: %v", err)
+		removeImage()
+		return rpc.ErrInternal
+	}
+
+	record := model.ContainerImport{
+		ImageID: id,
+		NodeID:  nodeInfo.ID,
+		Configs: string(data),
+		Status:  model.ImportWaitApproval,
+		Creator: getUserIDFromContext(stream.Context()),
+	}
+	if err := model.CreateContainerImport(&record); err != nil {
+		removeImage()
+		return rpc.ErrInternal
+	}
+
+	if err := stream.SendAndClose(&pb.ImportReply{ImageId: id, ImportId: record.ID}); err != nil {
+		log.Errorf("cannot send response: %v", err)
+		return rpc.ErrUnknown
+	}
+
+	log.Debugf("Import container image=%v import=%v ok", id, record.ID)
+	return nil
+}
+
+// 导入镜像审批通过后在节点上创建容器
+func createImportedContainer(r *model.ContainerImport) {
+	image, err := model.QueryImageByID(r.ImageID)
+	if err == model.ErrRecordNotFound {
+		r.Status, r.Reason = model.ImportFailed, "导入镜像已删除"
+		model.UpdateContainerImport(r)
+		return
+	} else if err != nil {
+		return
+	}
+
+	switch image.ApprovalStatus {
+	case model.ApprovalWait:
+		return
+	case model.ApprovalReject:
+		r.Status, r.Reason = model.ImportFailed, "导入镜像审批未通过"
+		model.UpdateContainerImport(r)
+		return
+	}
+
+	var configs pb.ContainerConfigs
+	if err := json.Unmarshal([]byte(r.Configs), &configs); err != nil {
+		log.Infof("unmarshal import=%v configs err=%v", r.ID, err)
+		r.Status, r.Reason = model.ImportFailed, "容器配置错误"
+		model.UpdateContainerImport(r)
+		return
+	}
+
+	nodeInfo, err := model.QueryNodeByID(r.NodeID)
+	if err == model.ErrRecordNotFound {
+		r.Status, r.Reason = model.ImportFailed, "节点不存在"
+		model.UpdateContainerImport(r)
+		return
+	} else if err != nil {
+		return
+	}
+
+	// 导出文件中的模板信息在本控制器中无效
+	configs.TemplateId, configs.TemplateVersion = 0, 0
+	reply, err := createContainer(nodeInfo, &pb.CreateRequest{NodeId: nodeInfo.ID, Configs: &configs}, r.Creator)
+	if err != nil {
+		r.Attempts++
+		log.Infof("create imported container import=%v attempts=%v err=%v", r.ID, r.Attempts, err)
+		if r.Attempts >= maxImportAttempts {
+			r.Status = model.ImportFailed
+			if s, ok := status.FromError(err); ok {
+				r.Reason = s.Message()
+			}
+		}
+		model.UpdateContainerImport(r)
+		return
+	}
+
+	r.Status, r.ContainerID = model.ImportSucceed, reply.ContainerId
+	model.UpdateContainerImport(r)
+}
+
+func CreateImportedContainers() {
+	for {
+		if isMaster() {
+			if imports, err := model.ListPendingContainerImports(); err != nil {
+				log.Infof("get container imports from DB err=%v", err)
+			} else {
+				for _, r := range imports {
+					createImportedContainer(r)
+				}
+			}
+		}
+		time.Sleep(time.Second * 30)
+	}
+}
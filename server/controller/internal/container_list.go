@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scmc/rpc/pb/container"
+)
+
+var containerStates = map[string]bool{
+	"created":    true,
+	"running":    true,
+	"paused":     true,
+	"restarting": true,
+	"removing":   true,
+	"exited":     true,
+	"dead":       true,
+}
+
+func checkListRequest(in *pb.ListRequest) error {
+	if in.PageSize < 0 {
+		return status.Errorf(codes.InvalidArgument, "分页参数错误")
+	} else if _, ok := pb.LIST_SORT_KEY_name[int32(in.SortKey)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "排序参数错误")
+	} else if _, err := path.Match(in.NamePattern, ""); err != nil {
+		return status.Errorf(codes.InvalidArgument, "容器名匹配参数错误")
+	}
+
+	for _, state := range in.States {
+		if !containerStates[state] {
+			return status.Errorf(codes.InvalidArgument, "容器状态参数错误")
+		}
+	}
+	return nil
+}
+
+// 分页位置 记录上一页最后一个容器的排序字段
+type listCursor struct {
+	Key    pb.LIST_SORT_KEY `json:"k"`
+	Desc   bool             `json:"d,omitempty"`
+	Str    string           `json:"s,omitempty"`
+	Num    int64            `json:"n,omitempty"`
+	NodeID int64            `json:"node"`
+	ID     string           `json:"id"`
+}
+
+func newListCursor(c *pb.NodeContainer, key pb.LIST_SORT_KEY, desc bool) *listCursor {
+	r := listCursor{Key: key, Desc: desc, NodeID: c.NodeId, ID: c.Info.GetId()}
+	switch key {
+	case pb.LIST_SORT_KEY_SORT_BY_NAME:
+		r.Str = c.Info.GetName()
+	case pb.LIST_SORT_KEY_SORT_BY_CREATED:
+		r.Num = c.Info.GetCreated()
+	case pb.LIST_SORT_KEY_SORT_BY_STARTED:
+		r.Num = c.Info.GetStarted()
+	case pb.LIST_SORT_KEY_SORT_BY_STATE:
+		r.Str = c.Info.GetState()
+	case pb.LIST_SORT_KEY_SORT_BY_IMAGE:
+		r.Str = c.Info.GetImage()
+	case pb.LIST_SORT_KEY_SORT_BY_NODE:
+		r.Str = c.NodeAddress
+	}
+	return &r
+}
+
+// 排序字段相同时依次比较节点ID和容器ID, 保证各节点结果合并后顺序稳定
+func (a *listCursor) less(b *listCursor) bool {
+	var r int
+	switch {
+	case a.Str != b.Str:
+		r = strings.Compare(a.Str, b.Str)
+	case a.Num != b.Num:
+		r = compareInt64(a.Num, b.Num)
+	case a.NodeID != b.NodeID:
+		r = compareInt64(a.NodeID, b.NodeID)
+	default:
+		r = strings.Compare(a.ID, b.ID)
+	}
+
+	if a.Desc {
+		return r > 0
+	}
+	return r < 0
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func (a *listCursor) encode() string {
+	data, _ := json.Marshal(a)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeListCursor(token string, key pb.LIST_SORT_KEY, desc bool) (*listCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var r listCursor
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	} else if r.Key != key || r.Desc != desc {
+		return nil, status.Errorf(codes.InvalidArgument, "分页标识与排序参数不一致")
+	}
+	return &r, nil
+}
+
+// 排序并分页 分页标识为上一页最后一个容器的位置, 节点容器增删不影响后续分页
+func paginateContainers(in *pb.ListRequest, containers []*pb.NodeContainer) ([]*pb.NodeContainer, string, error) {
+	cursors := make([]*listCursor, len(containers))
+	for i, c := range containers {
+		cursors[i] = newListCursor(c, in.SortKey, in.SortDesc)
+	}
+
+	index := make([]int, len(containers))
+	for i := range index {
+		index[i] = i
+	}
+	sort.Slice(index, func(i, j int) bool { return cursors[index[i]].less(cursors[index[j]]) })
+
+	start := 0
+	if in.PageToken != "" {
+		last, err := decodeListCursor(in.PageToken, in.SortKey, in.SortDesc)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, "", err
+			}
+			return nil, "", status.Errorf(codes.InvalidArgument, "分页标识错误")
+		}
+		start = sort.Search(len(index), func(i int) bool { return last.less(cursors[index[i]]) })
+	}
+
+	end, next := len(index), ""
+	if in.PageSize > 0 && start+int(in.PageSize) < end {
+		end = start + int(in.PageSize)
+		next = cursors[index[end-1]].encode()
+	}
+
+	r := make([]*pb.NodeContainer, 0, end-start)
+	for _, i := range index[start:end] {
+		r = append(r, containers[i])
+	}
+	return r, next, nil
+}
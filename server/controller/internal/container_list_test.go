@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scmc/rpc/pb/container"
+)
+
+func testNodeContainer(nodeID int64, id string, created int64) *pb.NodeContainer {
+	return &pb.NodeContainer{NodeId: nodeID, Info: &pb.ContainerInfo{Id: id, Name: "name-" + id, Created: created}}
+}
+
+func containerIDs(containers []*pb.NodeContainer) []string {
+	var r []string
+	for _, c := range containers {
+		r = append(r, c.Info.Id)
+	}
+	return r
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPaginateContainers(t *testing.T) {
+	containers := []*pb.NodeContainer{
+		testNodeContainer(2, "b", 100),
+		testNodeContainer(1, "a", 300),
+		testNodeContainer(1, "c", 100),
+		testNodeContainer(2, "d", 200),
+		testNodeContainer(1, "e", 100),
+	}
+
+	tests := []struct {
+		name     string
+		sortKey  pb.LIST_SORT_KEY
+		sortDesc bool
+		pageSize int32
+		want     [][]string
+	}{
+		{"all", pb.LIST_SORT_KEY_SORT_BY_NAME, false, 0, [][]string{{"a", "b", "c", "d", "e"}}},
+		{"name", pb.LIST_SORT_KEY_SORT_BY_NAME, false, 2, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+		{"name desc", pb.LIST_SORT_KEY_SORT_BY_NAME, true, 2, [][]string{{"e", "d"}, {"c", "b"}, {"a"}}},
+		{"created", pb.LIST_SORT_KEY_SORT_BY_CREATED, false, 2, [][]string{{"c", "e"}, {"b", "d"}, {"a"}}},
+		{"created desc", pb.LIST_SORT_KEY_SORT_BY_CREATED, true, 3, [][]string{{"a", "d", "b"}, {"e", "c"}}},
+		{"exact page", pb.LIST_SORT_KEY_SORT_BY_NAME, false, 5, [][]string{{"a", "b", "c", "d", "e"}}},
+	}
+
+	for _, tt := range tests {
+		in := pb.ListRequest{SortKey: tt.sortKey, SortDesc: tt.sortDesc, PageSize: tt.pageSize}
+		for i, want := range tt.want {
+			r, next, err := paginateContainers(&in, containers)
+			if err != nil {
+				t.Fatalf("%s: page %d: %v", tt.name, i, err)
+			}
+			if got := containerIDs(r); !equalStrings(got, want) {
+				t.Errorf("%s: page %d = %v, want %v", tt.name, i, got, want)
+			}
+			if last := i == len(tt.want)-1; last != (next == "") {
+				t.Errorf("%s: page %d next token = %q", tt.name, i, next)
+				break
+			}
+			in.PageToken = next
+		}
+	}
+}
+
+// 翻页期间容器增删不影响后续分页位置
+func TestPaginateContainersChanged(t *testing.T) {
+	in := pb.ListRequest{SortKey: pb.LIST_SORT_KEY_SORT_BY_NAME, PageSize: 2}
+	r, next, err := paginateContainers(&in, []*pb.NodeContainer{
+		testNodeContainer(1, "a", 0),
+		testNodeContainer(1, "b", 0),
+		testNodeContainer(1, "c", 0),
+		testNodeContainer(1, "d", 0),
+	})
+	if err != nil || !equalStrings(containerIDs(r), []string{"a", "b"}) {
+		t.Fatalf("first page = %v, err=%v", containerIDs(r), err)
+	}
+
+	in.PageToken = next
+	r, _, err = paginateContainers(&in, []*pb.NodeContainer{
+		testNodeContainer(1, "a", 0),
+		testNodeContainer(1, "c", 0),
+		testNodeContainer(1, "d", 0),
+		testNodeContainer(1, "aa", 0),
+	})
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+	if got, want := containerIDs(r), []string{"c", "d"}; !equalStrings(got, want) {
+		t.Errorf("second page = %v, want %v", got, want)
+	}
+}
+
+func TestPaginateContainersBadToken(t *testing.T) {
+	containers := []*pb.NodeContainer{testNodeContainer(1, "a", 0), testNodeContainer(1, "b", 0)}
+	_, next, _ := paginateContainers(&pb.ListRequest{SortKey: pb.LIST_SORT_KEY_SORT_BY_NAME, PageSize: 1}, containers)
+
+	tests := []struct {
+		name string
+		in   *pb.ListRequest
+	}{
+		{"not base64", &pb.ListRequest{PageToken: "!!"}},
+		{"not json", &pb.ListRequest{PageToken: "bm90IGpzb24"}},
+		{"sort key changed", &pb.ListRequest{SortKey: pb.LIST_SORT_KEY_SORT_BY_CREATED, PageToken: next}},
+		{"sort desc changed", &pb.ListRequest{SortKey: pb.LIST_SORT_KEY_SORT_BY_NAME, SortDesc: true, PageToken: next}},
+	}
+	for _, tt := range tests {
+		_, _, err := paginateContainers(tt.in, containers)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: err = %v, want InvalidArgument", tt.name, err)
+		}
+	}
+}
+
+func TestCheckListRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.ListRequest
+		ok   bool
+	}{
+		{"default", &pb.ListRequest{}, true},
+		{"filter", &pb.ListRequest{NamePattern: "web-*", States: []string{"running", "exited"}}, true},
+		{"page size", &pb.ListRequest{PageSize: -1}, false},
+		{"sort key", &pb.ListRequest{SortKey: 99}, false},
+		{"name pattern", &pb.ListRequest{NamePattern: "web-["}, false},
+		{"state", &pb.ListRequest{States: []string{"stopped"}}, false},
+	}
+	for _, tt := range tests {
+		if err := checkListRequest(tt.in); (err == nil) != tt.ok {
+			t.Errorf("%s: checkListRequest() = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+	}
+}
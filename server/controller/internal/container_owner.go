@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+)
+
+// 角色仅允许访问自己的容器时返回当前用户ID
+func containerOwnerScope(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value("CONTAINER_OWNER").(int64)
+	return userID, ok
+}
+
+func parseSharedUsers(s string) []int64 {
+	var r []int64
+	for _, v := range strings.Split(s, ",") {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+			r = append(r, id)
+		}
+	}
+	return r
+}
+
+func formatSharedUsers(ids []int64) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(s, ",")
+}
+
+// 容器创建者及共享用户可以访问
+func canAccessContainer(cfgs *model.ContainerConfigs, userID int64) bool {
+	if cfgs.Creator == userID {
+		return true
+	}
+
+	for _, id := range parseSharedUsers(cfgs.SharedUsers) {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func checkContainerAccess(ctx context.Context, nodeID int64, containerID string) error {
+	userID, ok := containerOwnerScope(ctx)
+	if !ok {
+		return nil
+	}
+
+	cfgs, err := model.GetContainerConfigs(nodeID, containerID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrContainerNotOwned
+		}
+		return rpc.ErrInternal
+	}
+
+	if !canAccessContainer(cfgs, userID) {
+		return rpc.ErrContainerNotOwned
+	}
+	return nil
+}
+
+// 备份按UUID关联容器配置
+func checkBackupAccess(ctx context.Context, backup *model.ContainerBackup) error {
+	userID, ok := containerOwnerScope(ctx)
+	if !ok {
+		return nil
+	}
+
+	cfgs, err := model.GetContainerConfigsByUUID(backup.UUID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return rpc.ErrContainerNotOwned
+		}
+		return rpc.ErrInternal
+	}
+
+	if !canAccessContainer(cfgs, userID) {
+		return rpc.ErrContainerNotOwned
+	}
+	return nil
+}
+
+// 批量操作时去掉无权访问的容器 并返回对应的失败信息
+func filterAccessibleContainers(ctx context.Context, nodeInfo *model.NodeInfo, containerIDs []string) ([]string, []*pb.ContainerFailInfo) {
+	userID, ok := containerOwnerScope(ctx)
+	if !ok {
+		return containerIDs, nil
+	}
+
+	allowed := make(map[string]bool)
+	if cfgs, err := model.GetContainerConfigsList(nodeInfo.ID, containerIDs); err != nil {
+		log.Warnf("model.GetContainerConfigsList err=%v", err)
+	} else {
+		for _, c := range cfgs {
+			if canAccessContainer(c, userID) {
+				allowed[c.ContainerID] = true
+			}
+		}
+	}
+
+	var (
+		r     []string
+		fails []*pb.ContainerFailInfo
+	)
+	for _, id := range containerIDs {
+		if allowed[id] {
+			r = append(r, id)
+			continue
+		}
+
+		s, _ := status.FromError(rpc.ErrContainerNotOwned)
+		fails = append(fails, &pb.ContainerFailInfo{
+			NodeInfo:    nodeInfo.Address,
+			ContainerId: id,
+			FailReason:  s.Message(),
+		})
+	}
+	return r, fails
+}
+
+// 按控制器保存的容器配置过滤容器列表
+func filterContainersByConfigs(containers []*pb.NodeContainer, match func(*model.ContainerConfigs) bool) ([]*pb.NodeContainer, error) {
+	cfgs, err := model.ListContainerConfigs()
+	if err != nil {
+		log.Warnf("list container configs err=%v", err)
+		return nil, err
+	}
+
+	matched := make(map[string]bool)
+	for i := range cfgs {
+		if match(&cfgs[i]) {
+			matched[cfgs[i].ContainerID] = true
+		}
+	}
+
+	var r []*pb.NodeContainer
+	for _, c := range containers {
+		if matched[c.Info.GetId()] {
+			r = append(r, c)
+		}
+	}
+	return r, nil
+}
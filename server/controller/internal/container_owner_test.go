@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"scmc/model"
+)
+
+func TestParseSharedUsers(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int64
+	}{
+		{"", nil},
+		{"2", []int64{2}},
+		{"2,3,10", []int64{2, 3, 10}},
+		{"2,,x,3", []int64{2, 3}},
+	}
+	for _, tt := range tests {
+		if got := parseSharedUsers(tt.s); !equalInt64s(got, tt.want) {
+			t.Errorf("parseSharedUsers(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+
+	for _, ids := range [][]int64{nil, {2}, {2, 3, 10}} {
+		s := formatSharedUsers(ids)
+		if got := parseSharedUsers(s); !equalInt64s(got, ids) {
+			t.Errorf("parseSharedUsers(formatSharedUsers(%v)) = %v", ids, got)
+		}
+	}
+}
+
+func TestCanAccessContainer(t *testing.T) {
+	cfgs := model.ContainerConfigs{Creator: 1, SharedUsers: "2,30"}
+	tests := []struct {
+		userID int64
+		want   bool
+	}{
+		{1, true},
+		{2, true},
+		{30, true},
+		{3, false},
+		{0, false},
+	}
+	for _, tt := range tests {
+		if got := canAccessContainer(&cfgs, tt.userID); got != tt.want {
+			t.Errorf("canAccessContainer(user=%v) = %v, want %v", tt.userID, got, tt.want)
+		}
+	}
+}
+
+func TestContainerOwnerScope(t *testing.T) {
+	if _, ok := containerOwnerScope(context.Background()); ok {
+		t.Errorf("containerOwnerScope(no owner) ok = true, want false")
+	}
+	if id, ok := containerOwnerScope(context.WithValue(context.Background(), "CONTAINER_OWNER", int64(5))); !ok || id != 5 {
+		t.Errorf("containerOwnerScope = %v, %v, want 5, true", id, ok)
+	}
+	// 非受限角色的用户不做限制
+	if err := checkContainerAccess(context.Background(), 1, "id"); err != nil {
+		t.Errorf("checkContainerAccess(no owner) = %v, want nil", err)
+	}
+}
@@ -0,0 +1,422 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+	"scmc/rpc/pb/network"
+)
+
+// 模板占位参数 ${VAR}
+var templateParamPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// 模板配置中声明的占位参数
+func templateParams(configJSON string) []string {
+	var r []string
+	seen := make(map[string]bool)
+	for _, m := range templateParamPattern.FindAllStringSubmatch(configJSON, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			r = append(r, m[1])
+		}
+	}
+	return r
+}
+
+// 替换模板中的占位参数 参数值按JSON字符串转义
+func renderTemplate(configJSON string, params map[string]string) (string, error) {
+	var missing []string
+	for _, p := range templateParams(configJSON) {
+		if _, ok := params[p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return "", status.Errorf(codes.InvalidArgument, "缺少模板参数: %s", strings.Join(missing, ","))
+	}
+
+	return templateParamPattern.ReplaceAllStringFunc(configJSON, func(s string) string {
+		v, _ := json.Marshal(params[s[2:len(s)-1]])
+		return string(v[1 : len(v)-1])
+	}), nil
+}
+
+func (s *ContainerServer) CreateFromTemplate(ctx context.Context, in *pb.CreateFromTemplateRequest) (*pb.CreateFromTemplateReply, error) {
+	if in.TemplateId <= 0 || in.NodeId < 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	t, err := model.FindTemplate(in.TemplateId)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	data, err := renderTemplate(t.ConfigJSON, in.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs pb.ContainerConfigs
+	if err := json.Unmarshal([]byte(data), &configs); err != nil {
+		log.Warnf("parse template id=%v json err=%v", t.Id, err)
+		return nil, rpc.ErrInternal
+	}
+
+	if in.Name != "" {
+		configs.Name = in.Name
+	}
+
+	if len(in.Envs) > 0 && configs.Envs == nil {
+		configs.Envs = make(map[string]string, len(in.Envs))
+	}
+	for k, v := range in.Envs {
+		configs.Envs[k] = v
+	}
+
+	for name, ip := range in.NetworkIps {
+		var found bool
+		for _, n := range configs.Networks {
+			if n.Interface == name {
+				n.IpAddress, found = ip, true
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "模板中不存在网卡%s", name)
+		}
+	}
+
+	if in.ResourceLimit != nil {
+		configs.ResouceLimit = in.ResourceLimit
+	}
+
+	configs.ContainerId, configs.Uuid, configs.Status = "", "", ""
+	configs.TemplateId, configs.TemplateVersion = t.Id, t.Version
+
+	reply, err := s.create(ctx, &pb.CreateRequest{NodeId: in.NodeId, Configs: &configs})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateFromTemplateReply{
+		ContainerId: reply.ContainerId,
+		Warnings:    reply.Warnings,
+		NodeId:      reply.NodeId,
+		Rejects:     reply.Rejects,
+	}, nil
+}
+
+func findTemplate(id int64) (*model.ContainerTemplates, error) {
+	t, err := model.FindTemplate(id)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+	return t, nil
+}
+
+func (s *ContainerServer) ListTemplateRevision(ctx context.Context, in *pb.ListTemplateRevisionRequest) (*pb.ListTemplateRevisionReply, error) {
+	if in.TemplateId <= 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	if _, err := findTemplate(in.TemplateId); err != nil {
+		return nil, err
+	}
+
+	revisions, err := model.ListTemplateRevisions(in.TemplateId)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	reply := pb.ListTemplateRevisionReply{}
+	for _, r := range revisions {
+		var c pb.ContainerConfigs
+		if err := json.Unmarshal([]byte(r.ConfigJSON), &c); err != nil {
+			log.Warnf("parse template id=%v version=%v json err=%v", r.TemplateId, r.Version, err)
+			return nil, rpc.ErrInternal
+		}
+		reply.Revisions = append(reply.Revisions, &pb.TemplateRevision{
+			Version:   r.Version,
+			NodeId:    r.NodeId,
+			Conf:      &c,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	return &reply, nil
+}
+
+// 模板指定版本的节点及配置 version为0时返回当前版本
+func templateRevision(t *model.ContainerTemplates, version int64) (*model.ContainerTemplateRevisions, error) {
+	if version == 0 || version == t.Version {
+		return &model.ContainerTemplateRevisions{
+			TemplateId: t.Id,
+			Version:    t.Version,
+			Name:       t.Name,
+			NodeId:     t.NodeId,
+			ConfigJSON: t.ConfigJSON,
+		}, nil
+	}
+
+	r, err := model.FindTemplateRevision(t.Id, version)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, status.Errorf(codes.NotFound, "模板版本%d不存在", version)
+		}
+		return nil, rpc.ErrInternal
+	}
+	return r, nil
+}
+
+// 将JSON配置展开为 路径->值, 数组元素以下标作为路径
+func flattenJSON(prefix string, v interface{}, r map[string]string) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, item := range t {
+			flattenJSON(join(k), item, r)
+		}
+	case []interface{}:
+		for i, item := range t {
+			flattenJSON(join(fmt.Sprint(i)), item, r)
+		}
+	case string:
+		r[prefix] = t
+	case nil:
+	default:
+		data, _ := json.Marshal(t)
+		r[prefix] = string(data)
+	}
+}
+
+func revisionValues(r *model.ContainerTemplateRevisions) (map[string]string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(r.ConfigJSON), &v); err != nil {
+		log.Warnf("parse template id=%v version=%v json err=%v", r.TemplateId, r.Version, err)
+		return nil, rpc.ErrInternal
+	}
+
+	values := make(map[string]string)
+	flattenJSON("", v, values)
+	values["node_id"] = fmt.Sprint(r.NodeId)
+	return values, nil
+}
+
+func diffTemplateRevision(from, to *model.ContainerTemplateRevisions) ([]*pb.TemplateChange, error) {
+	oldValues, err := revisionValues(from)
+	if err != nil {
+		return nil, err
+	}
+	newValues, err := revisionValues(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*pb.TemplateChange
+	for k, v := range oldValues {
+		if nv, ok := newValues[k]; !ok || nv != v {
+			changes = append(changes, &pb.TemplateChange{Path: k, OldValue: v, NewValue: nv})
+		}
+	}
+	for k, v := range newValues {
+		if _, ok := oldValues[k]; !ok {
+			changes = append(changes, &pb.TemplateChange{Path: k, NewValue: v})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+func (s *ContainerServer) DiffTemplate(ctx context.Context, in *pb.DiffTemplateRequest) (*pb.DiffTemplateReply, error) {
+	if in.TemplateId <= 0 || in.FromVersion <= 0 || in.ToVersion < 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	t, err := findTemplate(in.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := templateRevision(t, in.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := templateRevision(t, in.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffTemplateRevision(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiffTemplateReply{Changes: changes}, nil
+}
+
+func (s *ContainerServer) RollbackTemplate(ctx context.Context, in *pb.RollbackTemplateRequest) (*pb.RollbackTemplateReply, error) {
+	if in.TemplateId <= 0 || in.Version <= 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	t, err := findTemplate(in.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if in.Version == t.Version {
+		return nil, status.Errorf(codes.InvalidArgument, "已是版本%d", in.Version)
+	}
+
+	r, err := templateRevision(t, in.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// 模板名唯一 历史版本名称已被其他模板使用时无法回滚
+	if r.Name != t.Name {
+		if other, err := model.FindTemplateByName(r.Name); err == nil && other.Id != t.Id {
+			return nil, status.Errorf(codes.AlreadyExists, "模板名%s已被使用", r.Name)
+		}
+	}
+
+	version, err := model.UpdateTemplate(ctx, t.Id, r.Name, []byte(r.ConfigJSON), r.NodeId)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.RollbackTemplateReply{Version: version}, nil
+}
+
+// 节点上已存在的网卡名称
+func nodeNetworkNames(nodeInfo *model.NodeInfo) (map[string]bool, error) {
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	reply, err := network.NewNetworkClient(conn).List(ctx, &network.ListRequest{NodeId: nodeInfo.ID})
+	if err != nil {
+		log.Warnf("network list ID=%v address=%v: %v", nodeInfo.ID, nodeInfo.Address, err)
+		return nil, err
+	}
+
+	r := make(map[string]bool)
+	for _, ifs := range [][]*network.NetworkInterface{reply.RealIfs, reply.VirtualIfs} {
+		for _, i := range ifs {
+			r[i.Name] = true
+		}
+	}
+	return r, nil
+}
+
+// 检查模板在节点上创建容器的条件: 镜像已审批, 网卡存在, 节点资源满足限制
+// 由模板参数指定的值在创建时才能确定, 不做检查
+func validateTemplate(configs *pb.ContainerConfigs, nodeInfo *model.NodeInfo) ([]string, error) {
+	var problems []string
+
+	if configs.Image == "" {
+		problems = append(problems, "未指定镜像")
+	} else if !templateParamPattern.MatchString(configs.Image) {
+		images, err := model.QueryImageByStatus()
+		if err != nil {
+			return nil, rpc.ErrInternal
+		}
+
+		var approved bool
+		for _, i := range images {
+			if i.Name+":"+i.Version == configs.Image {
+				approved = true
+				break
+			}
+		}
+		if !approved {
+			problems = append(problems, fmt.Sprintf("镜像%s不存在或未审批通过", configs.Image))
+		}
+	}
+
+	if len(configs.Networks) > 0 {
+		names, err := nodeNetworkNames(nodeInfo)
+		if err != nil {
+			problems = append(problems, "查询节点网卡失败")
+		} else {
+			for _, n := range configs.Networks {
+				if !templateParamPattern.MatchString(n.Interface) && !names[n.Interface] {
+					problems = append(problems, fmt.Sprintf("节点上不存在网卡%s", n.Interface))
+				}
+			}
+		}
+	}
+
+	c := scheduleCandidate{node: nodeInfo}
+	c.status, _ = getNodeStatus(nodeInfo)
+	c.evaluate(configs.ResouceLimit)
+	if c.reason != "" {
+		problems = append(problems, c.reason)
+	}
+
+	return problems, nil
+}
+
+func (s *ContainerServer) ValidateTemplate(ctx context.Context, in *pb.ValidateTemplateRequest) (*pb.ValidateTemplateReply, error) {
+	if in.TemplateId <= 0 || in.NodeId < 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	t, err := findTemplate(in.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeID := in.NodeId
+	if nodeID == 0 {
+		nodeID = t.NodeId
+	}
+	nodeInfo, err := model.QueryNodeByID(nodeID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	var configs pb.ContainerConfigs
+	if err := json.Unmarshal([]byte(t.ConfigJSON), &configs); err != nil {
+		log.Warnf("parse template id=%v json err=%v", t.Id, err)
+		return nil, rpc.ErrInternal
+	}
+
+	problems, err := validateTemplate(&configs, nodeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateTemplateReply{Valid: len(problems) == 0, Problems: problems}, nil
+}
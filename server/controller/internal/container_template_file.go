@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/container"
+)
+
+// 模板导出文件 模板名即conf中的容器名
+type templateFile struct {
+	Templates []templateFileItem `json:"templates"`
+}
+
+type templateFileItem struct {
+	NodeID  int64            `json:"node_id"`
+	Version int64            `json:"version"` // 导出时的版本 导入时忽略
+	Conf    *json.RawMessage `json:"conf"`
+}
+
+func encodeTemplateFile(f *templateFile, format pb.TEMPLATE_FILE_FORMAT) ([]byte, error) {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil || format != pb.TEMPLATE_FILE_FORMAT_TEMPLATE_YAML {
+		return data, err
+	}
+
+	// 经通用结构转换 保持与JSON格式相同的字段名
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(v)
+}
+
+func decodeTemplateFile(data []byte, format pb.TEMPLATE_FILE_FORMAT) (*templateFile, error) {
+	if format == pb.TEMPLATE_FILE_FORMAT_TEMPLATE_YAML {
+		var v interface{}
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var f templateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (s *ContainerServer) ExportTemplate(ctx context.Context, in *pb.ExportTemplateRequest) (*pb.ExportTemplateReply, error) {
+	ids := uniqueInt64(in.Ids)
+	templates, err := model.QueryTemplates(ids)
+	if err != nil {
+		return nil, rpc.ErrInternal
+	} else if len(templates) < len(ids) {
+		return nil, rpc.ErrNotFound
+	}
+
+	var f templateFile
+	for _, t := range templates {
+		conf := json.RawMessage(t.ConfigJSON)
+		f.Templates = append(f.Templates, templateFileItem{
+			NodeID:  t.NodeId,
+			Version: t.Version,
+			Conf:    &conf,
+		})
+	}
+
+	data, err := encodeTemplateFile(&f, in.Format)
+	if err != nil {
+		log.Warnf("encode template file err=%v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	return &pb.ExportTemplateReply{Data: data}, nil
+}
+
+func (s *ContainerServer) ImportTemplate(ctx context.Context, in *pb.ImportTemplateRequest) (*pb.ImportTemplateReply, error) {
+	if len(in.Data) == 0 || in.NodeId < 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	f, err := decodeTemplateFile(in.Data, in.Format)
+	if err != nil {
+		log.Infof("decode template file err=%v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "模板文件格式错误")
+	} else if len(f.Templates) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "模板文件为空")
+	}
+
+	type importItem struct {
+		existID int64
+		nodeID  int64
+		conf    *pb.ContainerConfigs
+	}
+
+	// 先检查所有模板 避免部分导入
+	var items []importItem
+	names := make(map[string]bool)
+	for _, t := range f.Templates {
+		var conf pb.ContainerConfigs
+		if t.Conf == nil || json.Unmarshal(*t.Conf, &conf) != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "模板配置格式错误")
+		} else if !isValidContainerName(conf.Name) {
+			return nil, status.Errorf(codes.InvalidArgument, "模板名%s参数错误", conf.Name)
+		} else if !isValidContainerDesc(conf.Desc) {
+			return nil, status.Errorf(codes.InvalidArgument, "模板%s描述参数错误", conf.Name)
+		} else if names[conf.Name] {
+			return nil, status.Errorf(codes.InvalidArgument, "模板名%s重复", conf.Name)
+		}
+		names[conf.Name] = true
+
+		item := importItem{nodeID: t.NodeID, conf: &conf}
+		if in.NodeId > 0 {
+			item.nodeID = in.NodeId
+		}
+		if _, err := model.QueryNodeByID(item.nodeID); err != nil {
+			if err == model.ErrRecordNotFound {
+				return nil, status.Errorf(codes.NotFound, "模板%s的节点%d不存在", conf.Name, item.nodeID)
+			}
+			return nil, rpc.ErrInternal
+		}
+
+		if exist, err := model.FindTemplateByName(conf.Name); err == nil {
+			if !in.Overwrite {
+				return nil, status.Errorf(codes.AlreadyExists, "模板%s已存在", conf.Name)
+			}
+			item.existID = exist.Id
+		} else if err != model.ErrRecordNotFound {
+			return nil, rpc.ErrInternal
+		}
+
+		items = append(items, item)
+	}
+
+	var imports []*model.TemplateImport
+	for _, item := range items {
+		confbyte, err := json.Marshal(item.conf)
+		if err != nil {
+			return nil, rpc.ErrInternal
+		}
+
+		imports = append(imports, &model.TemplateImport{
+			Id:         item.existID,
+			Name:       item.conf.Name,
+			ConfigJSON: confbyte,
+			NodeId:     item.nodeID,
+		})
+	}
+
+	ids, err := model.ImportTemplates(ctx, imports)
+	if err != nil {
+		log.Warnf("import templates err=%v", err)
+		if err == model.ErrDuplicateKey {
+			return nil, status.Errorf(codes.AlreadyExists, "模板名已存在")
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	reply := pb.ImportTemplateReply{Ids: ids}
+	return &reply, nil
+}
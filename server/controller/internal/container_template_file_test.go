@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	pb "scmc/rpc/pb/container"
+)
+
+func TestTemplateFileRoundTrip(t *testing.T) {
+	conf := json.RawMessage(`{"name":"web","image":"nginx:1.20","envs":{"A":"1"},"ports":[{"public_port":80}]}`)
+	in := templateFile{Templates: []templateFileItem{{NodeID: 2, Version: 3, Conf: &conf}}}
+
+	for _, format := range []pb.TEMPLATE_FILE_FORMAT{pb.TEMPLATE_FILE_FORMAT_TEMPLATE_JSON, pb.TEMPLATE_FILE_FORMAT_TEMPLATE_YAML} {
+		data, err := encodeTemplateFile(&in, format)
+		if err != nil {
+			t.Fatalf("%v: encode: %v", format, err)
+		}
+		out, err := decodeTemplateFile(data, format)
+		if err != nil {
+			t.Fatalf("%v: decode %s: %v", format, data, err)
+		}
+
+		if len(out.Templates) != 1 {
+			t.Fatalf("%v: decode got %d templates, want 1", format, len(out.Templates))
+		}
+		item := out.Templates[0]
+		if item.NodeID != 2 || item.Version != 3 {
+			t.Errorf("%v: decode node_id=%v version=%v, want 2 3", format, item.NodeID, item.Version)
+		}
+
+		var want, got pb.ContainerConfigs
+		json.Unmarshal(conf, &want)
+		if item.Conf == nil || json.Unmarshal(*item.Conf, &got) != nil {
+			t.Fatalf("%v: decode conf %v", format, item.Conf)
+		}
+		if got.Name != want.Name || got.Image != want.Image || got.Envs["A"] != "1" || len(got.Ports) != 1 {
+			t.Errorf("%v: decode conf = %v, want %v", format, &got, &want)
+		}
+	}
+}
+
+func TestDecodeTemplateFileError(t *testing.T) {
+	tests := []struct {
+		data   string
+		format pb.TEMPLATE_FILE_FORMAT
+	}{
+		{`{"templates":`, pb.TEMPLATE_FILE_FORMAT_TEMPLATE_JSON},
+		{`{"templates":{}}`, pb.TEMPLATE_FILE_FORMAT_TEMPLATE_JSON},
+		{"templates: [\n", pb.TEMPLATE_FILE_FORMAT_TEMPLATE_YAML},
+		{"templates: abc\n", pb.TEMPLATE_FILE_FORMAT_TEMPLATE_YAML},
+	}
+	for _, tt := range tests {
+		if _, err := decodeTemplateFile([]byte(tt.data), tt.format); err == nil {
+			t.Errorf("decodeTemplateFile(%q, %v): want error", tt.data, tt.format)
+		}
+	}
+}
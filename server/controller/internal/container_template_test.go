@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+)
+
+func TestTemplateParams(t *testing.T) {
+	tests := []struct {
+		config string
+		want   []string
+	}{
+		{`{"image":"nginx"}`, nil},
+		{`{"image":"nginx:${TAG}","name":"${NAME}-${TAG}"}`, []string{"TAG", "NAME"}},
+		{`{"image":"${1TAG}","name":"$NAME","cmd":"${}"}`, nil},
+		{`{"envs":{"A":"${_a1}"}}`, []string{"_a1"}},
+	}
+	for _, tt := range tests {
+		if got := templateParams(tt.config); !equalStrings(got, tt.want) {
+			t.Errorf("templateParams(%s) = %v, want %v", tt.config, got, tt.want)
+		}
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	tests := []struct {
+		config string
+		params map[string]string
+		want   string
+	}{
+		{`{"image":"nginx"}`, nil, `{"image":"nginx"}`},
+		{`{"image":"nginx:${TAG}"}`, map[string]string{"TAG": "1.20"}, `{"image":"nginx:1.20"}`},
+		{`{"name":"${N}-${N}"}`, map[string]string{"N": "web", "UNUSED": "x"}, `{"name":"web-web"}`},
+		{`{"cmd":"${CMD}"}`, map[string]string{"CMD": `echo "a\b"`}, `{"cmd":"echo \"a\\b\""}`},
+		{`{"cmd":"${CMD}"}`, map[string]string{"CMD": "a\",\"privileged\":true,\"x\":\""}, `{"cmd":"a\",\"privileged\":true,\"x\":\""}`},
+		{`{"cmd":"${CMD}"}`, map[string]string{"CMD": "a\nb"}, `{"cmd":"a\nb"}`},
+		{`{"cmd":"${CMD}"}`, map[string]string{"CMD": ""}, `{"cmd":""}`},
+	}
+	for _, tt := range tests {
+		got, err := renderTemplate(tt.config, tt.params)
+		if err != nil {
+			t.Errorf("renderTemplate(%s, %v): %v", tt.config, tt.params, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("renderTemplate(%s, %v) = %s, want %s", tt.config, tt.params, got, tt.want)
+		}
+
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(got), &v); err != nil {
+			t.Errorf("renderTemplate(%s, %v): invalid json %s", tt.config, tt.params, got)
+		} else if len(v) != 1 {
+			t.Errorf("renderTemplate(%s, %v): got %d fields, want 1", tt.config, tt.params, len(v))
+		}
+	}
+}
+
+func TestRenderTemplateMissing(t *testing.T) {
+	_, err := renderTemplate(`{"image":"${IMAGE}:${TAG}","name":"${NAME}"}`, map[string]string{"TAG": "v1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("renderTemplate: err = %v, want InvalidArgument", err)
+	}
+	if want := "缺少模板参数: IMAGE,NAME"; status.Convert(err).Message() != want {
+		t.Errorf("renderTemplate: message = %q, want %q", status.Convert(err).Message(), want)
+	}
+}
+
+func TestFlattenJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want map[string]string
+	}{
+		{`{}`, map[string]string{}},
+		{`{"name":"web","privileged":true,"cpu":1.5,"desc":null}`, map[string]string{"name": "web", "privileged": "true", "cpu": "1.5"}},
+		{`{"envs":{"A":"1"},"ports":[{"host":80},{"host":443}]}`, map[string]string{"envs.A": "1", "ports.0.host": "80", "ports.1.host": "443"}},
+		{`{"cmd":["sh","-c"],"empty":[],"obj":{}}`, map[string]string{"cmd.0": "sh", "cmd.1": "-c"}},
+	}
+	for _, tt := range tests {
+		var v interface{}
+		if err := json.Unmarshal([]byte(tt.data), &v); err != nil {
+			t.Fatalf("unmarshal %s: %v", tt.data, err)
+		}
+		got := make(map[string]string)
+		flattenJSON("", v, got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("flattenJSON(%s) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestDiffTemplateRevision(t *testing.T) {
+	from := &model.ContainerTemplateRevisions{
+		NodeId:     1,
+		ConfigJSON: `{"name":"web","image":"nginx:1.20","envs":{"A":"1","B":"2"},"cmd":["a","b"]}`,
+	}
+	to := &model.ContainerTemplateRevisions{
+		NodeId:     2,
+		ConfigJSON: `{"name":"web","image":"nginx:1.21","envs":{"A":"1","C":"3"},"cmd":["a"]}`,
+	}
+
+	changes, err := diffTemplateRevision(from, to)
+	if err != nil {
+		t.Fatalf("diffTemplateRevision: %v", err)
+	}
+
+	want := []*pb.TemplateChange{
+		{Path: "cmd.1", OldValue: "b"},
+		{Path: "envs.B", OldValue: "2"},
+		{Path: "envs.C", NewValue: "3"},
+		{Path: "image", OldValue: "nginx:1.20", NewValue: "nginx:1.21"},
+		{Path: "node_id", OldValue: "1", NewValue: "2"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("diffTemplateRevision: got %v changes, want %v", changes, want)
+	}
+	for i, c := range changes {
+		w := want[i]
+		if c.Path != w.Path || c.OldValue != w.OldValue || c.NewValue != w.NewValue {
+			t.Errorf("change %d = %v, want %v", i, c, w)
+		}
+	}
+
+	if changes, err := diffTemplateRevision(from, from); err != nil || len(changes) != 0 {
+		t.Errorf("diffTemplateRevision(same) = %v, %v, want no change", changes, err)
+	}
+
+	bad := &model.ContainerTemplateRevisions{ConfigJSON: `{`}
+	if _, err := diffTemplateRevision(from, bad); err == nil {
+		t.Errorf("diffTemplateRevision(bad json): want error")
+	}
+}
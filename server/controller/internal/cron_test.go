@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		spec string
+		ok   bool
+	}{
+		{"* * * * *", true},
+		{"30 2 * * *", true},
+		{"0,15,30,45 8-18 * * 1-5", true},
+		{"*/5 */2 1-10/3 * 7", true},
+		{"5/10 * * * *", true},
+		{" @daily ", true},
+		{"@weekly", true},
+		{"", false},
+		{"* * * *", false},
+		{"* * * * * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * * 13 *", false},
+		{"* * * * 8", false},
+		{"10-5 * * * *", false},
+		{"*/0 * * * *", false},
+		{"*/x * * * *", false},
+		{"a * * * *", false},
+		{"1-b * * * *", false},
+		{"1, * * * *", false},
+		{"@yearly", false},
+	}
+	for _, tt := range tests {
+		if _, err := parseCron(tt.spec); (err == nil) != tt.ok {
+			t.Errorf("parseCron(%q) err = %v, want ok=%v", tt.spec, err, tt.ok)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	layout := "2006-01-02 15:04"
+	tests := []struct {
+		spec string
+		from string
+		want string
+	}{
+		{"* * * * *", "2022-03-01 10:00", "2022-03-01 10:01"},
+		{"30 2 * * *", "2022-03-01 02:29", "2022-03-01 02:30"},
+		{"30 2 * * *", "2022-03-01 02:30", "2022-03-02 02:30"},
+		{"@hourly", "2022-12-31 23:10", "2023-01-01 00:00"},
+		{"*/15 * * * *", "2022-03-01 10:44", "2022-03-01 10:45"},
+		{"5/20 * * * *", "2022-03-01 10:46", "2022-03-01 11:05"},
+		{"0 9 * * 1-5", "2022-03-04 10:00", "2022-03-07 09:00"}, // 周五之后为周一
+		{"0 0 * * 7", "2022-03-01 00:00", "2022-03-06 00:00"},   // 7为周日
+		{"0 0 31 * *", "2022-04-01 00:00", "2022-05-31 00:00"},  // 跳过没有31日的月份
+		{"0 0 29 2 *", "2022-01-01 00:00", "2024-02-29 00:00"},
+		{"0 0 1 * 1", "2022-03-02 00:00", "2022-03-07 00:00"}, // 日和周满足其一
+		{"0 0 13 * 5", "2022-05-07 00:00", "2022-05-13 00:00"},
+		{"@monthly", "2022-01-31 12:00", "2022-02-01 00:00"},
+	}
+	for _, tt := range tests {
+		c, err := parseCron(tt.spec)
+		if err != nil {
+			t.Fatalf("parseCron(%q): %v", tt.spec, err)
+		}
+		from, _ := time.ParseInLocation(layout, tt.from, time.UTC)
+		if got := c.next(from).Format(layout); got != tt.want {
+			t.Errorf("parseCron(%q).next(%s) = %s, want %s", tt.spec, tt.from, got, tt.want)
+		}
+	}
+
+	// 2月30日不存在 5年内无匹配
+	c, _ := parseCron("0 0 30 2 *")
+	if got := c.next(time.Now()); !got.IsZero() {
+		t.Errorf("parseCron(\"0 0 30 2 *\").next() = %v, want zero", got)
+	}
+}
@@ -0,0 +1,394 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"scmc/common"
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+	"scmc/rpc/pb/logging"
+	"scmc/rpc/pb/node"
+)
+
+// 节点离线起始时间及是否已执行故障转移, 仅在NodeStatusMonitor中访问
+var (
+	nodeOfflineSince = make(map[int64]time.Time)
+	nodeFailoverDone = make(map[int64]bool)
+)
+
+// 故障转移任务在后台执行, 避免阻塞节点状态检查
+const failoverQueueSize = 100
+
+type failoverTask struct {
+	node  *node.NodeInfo
+	cfgs  *model.ContainerConfigs
+	epoch int64
+}
+
+var failover = struct {
+	sync.Mutex
+	queue   chan *failoverTask
+	pending map[string]bool // 排队或执行中的容器UUID
+	epochs  map[int64]int64 // 节点恢复在线后递增, 取消排队中的任务
+}{
+	queue:   make(chan *failoverTask, failoverQueueSize),
+	pending: make(map[string]bool),
+	epochs:  make(map[int64]int64),
+}
+
+// 加入故障转移队列, 队列已满时返回false
+func enqueueFailover(n *node.NodeInfo, cfgs *model.ContainerConfigs) bool {
+	failover.Lock()
+	defer failover.Unlock()
+
+	if failover.pending[cfgs.UUID] {
+		return true
+	}
+
+	select {
+	case failover.queue <- &failoverTask{node: n, cfgs: cfgs, epoch: failover.epochs[n.Id]}:
+		failover.pending[cfgs.UUID] = true
+		return true
+	default:
+		return false
+	}
+}
+
+// 节点恢复在线, 丢弃尚未执行的故障转移任务
+func cancelNodeFailover(nodeID int64) {
+	failover.Lock()
+	failover.epochs[nodeID]++
+	failover.Unlock()
+}
+
+func failoverWorker() {
+	for t := range failover.queue {
+		failover.Lock()
+		canceled := t.epoch != failover.epochs[t.node.Id]
+		failover.Unlock()
+
+		// 排队期间容器可能已被迁移, 删除或关闭高可用
+		if !canceled {
+			cfgs, err := model.GetContainerConfigsByUUID(t.cfgs.UUID)
+			if err != nil {
+				log.Infof("failover: GetContainerConfigsByUUID uuid=%v err=%v", t.cfgs.UUID, err)
+			} else if cfgs.NodeID == t.node.Id && cfgs.HaEnabled {
+				failoverContainer(t.node, cfgs, t.epoch)
+			}
+		}
+
+		failover.Lock()
+		delete(failover.pending, t.cfgs.UUID)
+		failover.Unlock()
+	}
+}
+
+// StartFailoverWorkers 启动故障转移工作协程
+func StartFailoverWorkers(n int) {
+	if n <= 0 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		go failoverWorker()
+	}
+}
+
+// 保存容器配置用于故障转移重建, 安全配置单独存储
+func setStoredConfigs(cfgs *model.ContainerConfigs, c *pb.ContainerConfigs) {
+	if c == nil {
+		return
+	}
+
+	stored := proto.Clone(c).(*pb.ContainerConfigs)
+	stored.ContainerId = ""
+	stored.Status = ""
+	stored.SecurityConfig = nil
+	data, err := json.Marshal(stored)
+	if err != nil {
+		log.Warnf("Marshal ContainerConfigs err: %v", err)
+		return
+	}
+
+	cfgs.Configs = string(data)
+	cfgs.HaEnabled = c.RestartPolicy.GetHa()
+}
+
+func getStoredConfigs(cfgs *model.ContainerConfigs) *pb.ContainerConfigs {
+	if cfgs.Configs == "" {
+		return nil
+	}
+
+	var c pb.ContainerConfigs
+	if err := json.Unmarshal([]byte(cfgs.Configs), &c); err != nil {
+		log.Infof("unmarshal container configs uuid=%v err=%v", cfgs.UUID, err)
+		return nil
+	}
+	return &c
+}
+
+func failoverLog(n *node.NodeInfo, cfgs *model.ContainerConfigs, dst *model.NodeInfo, detail string, err error) {
+	model.CreateWarnLog([]*model.WarnLog{
+		{
+			NodeId:        n.Id,
+			NodeInfo:      fmt.Sprintf("%s (%s)", n.Name, n.Address),
+			EventType:     int64(logging.EVENT_TYPE_WARN_CONTAINER_FAILOVER),
+			EventModule:   int64(logging.EVENT_MODULE_CONTAINER),
+			ContainerName: cfgs.ContainerName,
+			Detail:        detail,
+		},
+	})
+
+	r := &model.RuntimeLog{
+		EventType:   int64(logging.EVENT_TYPE_FAILOVER_CONTAINER),
+		EventType_:  logging.EVENT_TYPE_FAILOVER_CONTAINER.String(),
+		EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+		NodeId:      n.Id,
+		NodeInfo:    fmt.Sprintf("%s (%s)", n.Name, n.Address),
+		Target:      fmt.Sprintf("容器=%v", cfgs.ContainerName),
+		Detail:      detail,
+	}
+	if dst != nil {
+		r.NodeId = dst.ID
+		r.NodeInfo = fmt.Sprintf("%s (%s)", dst.Name, dst.Address)
+	}
+	if s, _ := status.FromError(err); s != nil {
+		r.StatusCode = int64(s.Code())
+		r.Error = s.Message()
+	}
+	appendRuntimeLog(r)
+}
+
+// 原节点是否已恢复在线
+func nodeRecovered(n *node.NodeInfo) bool {
+	conn, err := getAgentConn(n.Address)
+	if err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	_, err = node.NewNodeClient(conn).Status(ctx, &node.StatusRequest{})
+	return err == nil
+}
+
+// 在调度选出的节点上重建容器, 优先使用最近一次成功的备份镜像
+func failoverContainer(n *node.NodeInfo, cfgs *model.ContainerConfigs, epoch int64) {
+	configs := getStoredConfigs(cfgs)
+	if configs == nil {
+		failoverLog(n, cfgs, nil, "故障转移失败: 缺少容器配置", nil)
+		return
+	}
+
+	if cfgs.SecurityConfig != "" {
+		var secCfg pb.SecurityConfig
+		if err := json.Unmarshal([]byte(cfgs.SecurityConfig), &secCfg); err != nil {
+			log.Infof("json unmarshal security config err=%v", err)
+		} else {
+			configs.SecurityConfig = &secCfg
+		}
+	}
+	configs.Uuid = cfgs.UUID
+
+	images := []string{configs.Image}
+	backup, err := model.QueryLatestContainerBackup(cfgs.UUID)
+	if err == nil && backup.ImageRef != "" {
+		images = []string{backup.ImageRef, configs.Image}
+	} else {
+		backup = nil
+	}
+
+	dst, _, err := scheduleNode(configs.ResouceLimit, configs.Ports)
+	if err != nil {
+		failoverLog(n, cfgs, nil, "故障转移失败: 没有可用节点", err)
+		return
+	}
+
+	if err := checkDevicesAllowed(dst, configs.Devices); err != nil {
+		failoverLog(n, cfgs, dst, "故障转移失败: 目标节点不允许直通设备", err)
+		return
+	}
+
+	conn, err := getAgentConn(dst.Address)
+	if err != nil {
+		failoverLog(n, cfgs, dst, "故障转移失败: 连接目标节点失败", err)
+		return
+	}
+
+	if err := ensureSeccompProfile(conn, configs.SecurityConfig.GetSeccompProfile()); err != nil {
+		failoverLog(n, cfgs, dst, "故障转移失败: 下发seccomp配置失败", err)
+		return
+	}
+
+	cli := pb.NewContainerClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+
+	var reply *pb.MigrateReply
+	// 已推送至仓库的备份镜像由目标节点拉取
+	if backup != nil && backup.RegistryRef != "" {
+		var r *pb.ResumeBackupReply
+		r, err = cli.ResumeBackup(ctx, &pb.ResumeBackupRequest{
+			ImageRef:        backup.ImageRef,
+			RegistryRef:     backup.RegistryRef,
+			SecurityConfig:  configs.SecurityConfig,
+			Configs:         configs,
+			DeviceAllowlist: nodeAllowedDevices(dst),
+		})
+		if err == nil {
+			configs.Image, images = backup.ImageRef, nil
+			reply = &pb.MigrateReply{ContainerId: r.ContainerId}
+		} else {
+			log.Infof("failover container=%v backup=%v to node=%v err=%v", cfgs.ContainerName, backup.RegistryRef, dst.Address, err)
+		}
+	}
+	for _, image := range images {
+		configs.Image = image
+		reply, err = cli.Migrate(ctx, &pb.MigrateRequest{Configs: configs, DeviceAllowlist: nodeAllowedDevices(dst)})
+		if err == nil {
+			break
+		}
+		log.Infof("failover container=%v image=%v to node=%v err=%v", cfgs.ContainerName, image, dst.Address, err)
+	}
+	if err != nil {
+		failoverLog(n, cfgs, dst, fmt.Sprintf("故障转移至节点 %s (%s) 失败", dst.Name, dst.Address), err)
+		return
+	}
+
+	ids := []*pb.ContainerIdList{{ContainerIds: []string{reply.ContainerId}}}
+	if _, err := cli.Start(ctx, &pb.StartRequest{Ids: ids}); err != nil {
+		log.Warnf("start container=%v on node=%v err=%v", reply.ContainerId, dst.Address, err)
+	}
+
+	// 执行期间原节点恢复在线时删除新建的容器, 否则同一容器在两个节点上运行
+	// 更新配置时持有队列锁, 之后恢复的节点由cleanFailoverContainers清理原容器
+	recovered := nodeRecovered(n)
+	failover.Lock()
+	if recovered || epoch != failover.epochs[n.Id] {
+		failover.Unlock()
+		removeFailoverContainer(conn, reply.ContainerId)
+		failoverLog(n, cfgs, dst, "原节点已恢复在线, 取消故障转移", nil)
+		return
+	}
+
+	cfgs.NodeID = dst.ID
+	cfgs.ContainerID = reply.ContainerId
+	setStoredConfigs(cfgs, configs)
+	if err := model.UpdateContainerConfigs(cfgs); err != nil {
+		log.Warnf("failover: UpdateContainerConfigs data=%+v err=%v", cfgs, err)
+	}
+	failover.Unlock()
+
+	failoverLog(n, cfgs, dst, fmt.Sprintf("已故障转移至节点 %s (%s) 镜像=%s", dst.Name, dst.Address, configs.Image), nil)
+}
+
+func removeFailoverContainer(conn *grpc.ClientConn, id string) {
+	if _, err := StopContainer(conn, id); err != nil {
+		log.Infof("StopContainer %v err=%v", id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ids := []*pb.ContainerIdList{{ContainerIds: []string{id}}}
+	if _, err := pb.NewContainerClient(conn).Remove(ctx, &pb.RemoveRequest{Ids: ids}); err != nil {
+		log.Warnf("remove failover container=%v err=%v", id, err)
+	}
+}
+
+// 节点恢复后清理已转移至其他节点的容器, 避免同一容器重复运行
+func cleanFailoverContainers(n *node.NodeInfo) {
+	conn, err := getAgentConn(n.Address)
+	if err != nil {
+		return
+	}
+
+	r, err := ListContainer(conn, true)
+	if err != nil {
+		log.Warnf("ListContainer node=%v err=%v", n.Address, err)
+		return
+	}
+
+	var toRemove []string
+	for _, c := range r.Containers {
+		if c.Info == nil {
+			continue
+		}
+
+		uuid, ok := c.Info.Labels["KS_SCMC_UUID"]
+		if !ok || uuid == "" {
+			continue
+		}
+
+		cfgs, err := model.GetContainerConfigsByUUID(uuid)
+		if err != nil || cfgs.NodeID == n.Id || !cfgs.HaEnabled {
+			continue
+		}
+
+		if _, err := StopContainer(conn, c.Info.Id); err != nil {
+			log.Infof("StopContainer %v err=%v", c.Info.Id, err)
+		}
+		toRemove = append(toRemove, c.Info.Id)
+	}
+
+	if len(toRemove) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		cli := pb.NewContainerClient(conn)
+		ids := []*pb.ContainerIdList{{ContainerIds: toRemove}}
+		if _, err := cli.Remove(ctx, &pb.RemoveRequest{Ids: ids}); err != nil {
+			log.Warnf("remove failover containers=%v on node=%v err=%v", toRemove, n.Address, err)
+		}
+	}
+}
+
+func checkNodeFailover(nodes []*node.NodeInfo) {
+	grace := time.Duration(common.Config.Controller.FailoverGrace) * time.Second
+	for _, n := range nodes {
+		if n.Status != nil {
+			if _, ok := nodeOfflineSince[n.Id]; ok {
+				delete(nodeOfflineSince, n.Id)
+				// 已有容器加入故障转移队列
+				if _, ok := nodeFailoverDone[n.Id]; ok {
+					delete(nodeFailoverDone, n.Id)
+					cancelNodeFailover(n.Id)
+					cleanFailoverContainers(n)
+				}
+			}
+			continue
+		}
+
+		since, ok := nodeOfflineSince[n.Id]
+		if !ok {
+			nodeOfflineSince[n.Id] = time.Now()
+			continue
+		}
+
+		if nodeFailoverDone[n.Id] || time.Since(since) < grace {
+			continue
+		}
+
+		nodeFailoverDone[n.Id] = true
+		list, err := model.ListHAContainerConfigs(n.Id)
+		if err != nil {
+			log.Warnf("ListHAContainerConfigs node=%v err=%v", n.Id, err)
+			continue
+		}
+
+		for _, cfgs := range list {
+			log.Infof("node=%v offline since %v, failover container=%v", n.Address, since, cfgs.ContainerName)
+			if !enqueueFailover(n, cfgs) {
+				// 队列已满, 下一轮检查时重新加入
+				log.Warnf("failover queue full, delay container=%v", cfgs.ContainerName)
+				nodeFailoverDone[n.Id] = false
+			}
+		}
+	}
+}
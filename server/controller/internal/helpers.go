@@ -1,12 +1,16 @@
 package internal
 
 import (
+	"fmt"
 	"math"
 	"net"
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 	"unicode/utf8"
 
+	"scmc/model"
 	pb "scmc/rpc/pb/container"
 	"scmc/rpc/pb/security"
 )
@@ -15,6 +19,9 @@ const (
 	containerNamePattern = `^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`
 	imageNamePattern     = `^[a-z0-9]([a-z0-9_.-]*[a-z0-9])?$`
 	imageVersionPattern  = `^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`
+	seccompNamePattern   = `^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`
+	capabilityPattern    = `^[A-Z][A-Z_]*$`
+	cpusetPattern        = `^[0-9]+(-[0-9]+)?(,[0-9]+(-[0-9]+)?)*$`
 )
 
 func isValidNodeAddr(s string) bool {
@@ -52,6 +59,136 @@ func isValidContainerDesc(s string) bool {
 	return c <= 200
 }
 
+func isValidHealthCheck(h *pb.HealthCheck) bool {
+	if h == nil {
+		return true
+	}
+	return h.Interval >= 0 && h.Timeout >= 0 && h.Retries >= 0 && h.StartPeriod >= 0
+}
+
+func portType(p *pb.Port) string {
+	if p.Type == "" {
+		return "tcp"
+	}
+	return p.Type
+}
+
+// 主机端口相同且监听地址重叠时冲突
+func isPortConflict(a, b *pb.Port) bool {
+	if a.PublicPort == 0 || a.PublicPort != b.PublicPort || portType(a) != portType(b) {
+		return false
+	}
+
+	isAny := func(ip string) bool {
+		return ip == "" || ip == "0.0.0.0" || ip == "::"
+	}
+	return isAny(a.Ip) || isAny(b.Ip) || a.Ip == b.Ip
+}
+
+// 端口映射相同 不考虑顺序
+func samePorts(a, b []*pb.Port) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	key := func(p *pb.Port) string {
+		return fmt.Sprintf("%s:%d:%d/%s", p.Ip, p.PublicPort, p.PrivatePort, portType(p))
+	}
+	m := make(map[string]int)
+	for _, p := range a {
+		m[key(p)]++
+	}
+	for _, p := range b {
+		if m[key(p)] == 0 {
+			return false
+		}
+		m[key(p)]--
+	}
+	return true
+}
+
+func isValidPorts(ports []*pb.Port) bool {
+	for i, p := range ports {
+		if p.PrivatePort == 0 || p.PrivatePort > 65535 || p.PublicPort > 65535 {
+			return false
+		} else if t := portType(p); t != "tcp" && t != "udp" {
+			return false
+		} else if p.Ip != "" && net.ParseIP(p.Ip) == nil {
+			return false
+		}
+
+		for _, q := range ports[:i] {
+			if isPortConflict(p, q) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isValidDeviceAllowlist(paths []string) bool {
+	for _, p := range paths {
+		if !strings.HasPrefix(p, "/dev/") || strings.Contains(p, ",") || filepath.Clean(p) != p {
+			return false
+		} else if _, err := filepath.Match(p, ""); err != nil {
+			return false
+		} else if model.IsDeniedDevice(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidDevices(devices []*pb.DeviceMapping) bool {
+	for _, d := range devices {
+		if !filepath.IsAbs(d.PathOnHost) || (d.PathInContainer != "" && !filepath.IsAbs(d.PathInContainer)) {
+			return false
+		} else if strings.Trim(d.CgroupPermissions, "rwm") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidResourceLimit(r *pb.ResourceLimit) bool {
+	if r == nil {
+		return true
+	}
+
+	for _, s := range []string{r.CpusetCpus, r.CpusetMems} {
+		if s != "" && !regexp.MustCompile(cpusetPattern).MatchString(s) {
+			return false
+		}
+	}
+
+	if r.OomScoreAdj < -1000 || r.OomScoreAdj > 1000 || r.PidsLimit < 0 {
+		return false
+	} else if r.MemorySwap < 0 && r.MemorySwap != -1 {
+		return false
+	} else if r.MemorySwap > 0 && (r.MemoryLimit <= 0 || r.MemorySwap < r.MemoryLimit) {
+		// 设置交换分区限制时须同时设置内存限制, 且不小于内存限制
+		return false
+	}
+
+	devices := make(map[string]bool)
+	for _, t := range r.BlkioThrottles {
+		if !strings.HasPrefix(filepath.Clean(t.Device), "/dev/") || devices[t.Device] {
+			return false
+		}
+		devices[t.Device] = true
+	}
+	return true
+}
+
+func isValidNetworkBandwidth(networks []*pb.NetworkConfig) bool {
+	for _, n := range networks {
+		if n.IngressRate < 0 || n.EgressRate < 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func isValidImageName(s string) bool {
 	c := utf8.RuneCountInString(s)
 	if c < 1 || c > 50 {
@@ -85,17 +222,39 @@ func resourceLimitDiff(r0, r1 *pb.ResourceLimit) bool {
 	} else if r0 != nil && r1 != nil {
 		return math.Abs(r0.CpuLimit-r1.CpuLimit) > 1e-9 || r0.CpuPrio != r1.CpuPrio ||
 			math.Abs(r0.MemoryLimit-r1.MemoryLimit) > 1e-9 ||
-			math.Abs(r0.MemorySoftLimit-r1.MemorySoftLimit) > 1e-9
+			math.Abs(r0.MemorySoftLimit-r1.MemorySoftLimit) > 1e-9 ||
+			math.Abs(r0.MemorySwap-r1.MemorySwap) > 1e-9 ||
+			r0.CpusetCpus != r1.CpusetCpus || r0.CpusetMems != r1.CpusetMems ||
+			r0.PidsLimit != r1.PidsLimit || r0.OomScoreAdj != r1.OomScoreAdj ||
+			blkioThrottlesDiff(r0.BlkioThrottles, r1.BlkioThrottles)
 
 	}
 	return true
 }
 
+func blkioThrottlesDiff(t0, t1 []*pb.BlkioThrottle) bool {
+	if len(t0) != len(t1) {
+		return true
+	}
+
+	m := make(map[string]*pb.BlkioThrottle, len(t1))
+	for _, t := range t1 {
+		m[t.Device] = t
+	}
+	for _, t := range t0 {
+		t_, ok := m[t.Device]
+		if !ok || t.ReadBps != t_.ReadBps || t.WriteBps != t_.WriteBps || t.ReadIops != t_.ReadIops || t.WriteIops != t_.WriteIops {
+			return true
+		}
+	}
+	return false
+}
+
 func restartPolicyDiff(r0, r1 *pb.RestartPolicy) bool {
 	if r0 == nil && r1 == nil {
 		return false
 	} else if r0 != nil && r1 != nil {
-		return r0.Name != r1.Name || r0.MaxRetry != r1.MaxRetry
+		return r0.Name != r1.Name || r0.MaxRetry != r1.MaxRetry || r0.Ha != r1.Ha
 	}
 	return true
 }
@@ -117,6 +276,8 @@ func networkDiff(n0, n1 []*pb.NetworkConfig) bool {
 		} else if p0 != nil && p1 != nil {
 			if p0.Interface != p1.Interface || p0.IpAddress != p1.IpAddress || p0.MacAddress != p1.MacAddress {
 				return true
+			} else if p0.IngressRate != p1.IngressRate || p0.EgressRate != p1.EgressRate {
+				return true
 			}
 		}
 	}
@@ -146,8 +307,35 @@ func isEmptySecurityConfig(c *pb.SecurityConfig) bool {
 	file := c.FileProtection == nil || (!c.FileProtection.IsOn && len(c.FileProtection.FileList) == 0)
 	net := c.NetworkRule == nil || (!c.NetworkRule.IsOn)
 	cmd := !c.DisableCmdOperation
+	hardening := !c.NoNewPrivileges && !c.ReadOnlyRootfs && c.SeccompProfile == "" &&
+		len(c.CapAdd) == 0 && len(c.CapDrop) == 0 && len(c.Tmpfs) == 0
 
-	return proc && nproc && file && net && cmd
+	return proc && nproc && file && net && cmd && hardening
+}
+
+func isValidHardeningConfig(c *pb.SecurityConfig) bool {
+	if c == nil {
+		return true
+	}
+
+	for _, caps := range [][]string{c.CapAdd, c.CapDrop} {
+		for _, name := range caps {
+			if !regexp.MustCompile(capabilityPattern).MatchString(name) {
+				return false
+			}
+		}
+	}
+
+	if c.SeccompProfile != "" && !regexp.MustCompile(seccompNamePattern).MatchString(c.SeccompProfile) {
+		return false
+	}
+
+	for target := range c.Tmpfs {
+		if !filepath.IsAbs(target) || filepath.Clean(target) == "/" {
+			return false
+		}
+	}
+	return true
 }
 
 func procProtectionDiff(p0, p1 *security.ProcProtection) bool {
@@ -227,7 +415,48 @@ func containerSecurityConfigDiff(s0, s1 *pb.SecurityConfig) bool {
 			procProtectionDiff(s0.NprocProtection, s1.NprocProtection) ||
 			fileProtectionDiff(s0.FileProtection, s1.FileProtection) ||
 			networkRulesDiff(s0.NetworkRule, s1.NetworkRule) ||
-			s0.DisableCmdOperation != s1.DisableCmdOperation
+			s0.DisableCmdOperation != s1.DisableCmdOperation ||
+			hardeningConfigDiff(s0, s1)
 	}
 	return true
 }
+
+func hardeningConfigDiff(s0, s1 *pb.SecurityConfig) bool {
+	if s0.NoNewPrivileges != s1.NoNewPrivileges || s0.ReadOnlyRootfs != s1.ReadOnlyRootfs || s0.SeccompProfile != s1.SeccompProfile {
+		return true
+	} else if strSliceDiff(s0.CapAdd, s1.CapAdd) || strSliceDiff(s0.CapDrop, s1.CapDrop) {
+		return true
+	} else if len(s0.Tmpfs) != len(s1.Tmpfs) {
+		return true
+	}
+
+	for k, v := range s0.Tmpfs {
+		if v1, ok := s1.Tmpfs[k]; !ok || v1 != v {
+			return true
+		}
+	}
+	return false
+}
+
+func isProtectedPath(fileList []string, path string) bool {
+	path = filepath.Clean(path)
+	for _, f := range fileList {
+		f = filepath.Clean(f)
+		if path == f || strings.HasPrefix(path, strings.TrimSuffix(f, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// 标记被修改的防篡改保护文件 返回被修改的保护文件数
+func markProtectedChanges(changes []*pb.FileChange, fileList []string) int64 {
+	var count int64
+	for _, c := range changes {
+		if isProtectedPath(fileList, c.Path) {
+			c.Protected = true
+			count++
+		}
+	}
+	return count
+}
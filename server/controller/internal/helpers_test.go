@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"testing"
+
+	pb "scmc/rpc/pb/container"
+)
+
+func TestIsPortConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *pb.Port
+		want bool
+	}{
+		{"same port", &pb.Port{PublicPort: 80}, &pb.Port{PublicPort: 80}, true},
+		{"different port", &pb.Port{PublicPort: 80}, &pb.Port{PublicPort: 81}, false},
+		{"random host port", &pb.Port{PublicPort: 0}, &pb.Port{PublicPort: 0}, false},
+		{"default type is tcp", &pb.Port{PublicPort: 53, Type: "tcp"}, &pb.Port{PublicPort: 53}, true},
+		{"tcp and udp", &pb.Port{PublicPort: 53, Type: "udp"}, &pb.Port{PublicPort: 53}, false},
+		{"different ip", &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, &pb.Port{PublicPort: 80, Ip: "10.0.0.2"}, false},
+		{"same ip", &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, true},
+		{"any ipv4", &pb.Port{PublicPort: 80, Ip: "0.0.0.0"}, &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, true},
+		{"any ipv6", &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, &pb.Port{PublicPort: 80, Ip: "::"}, true},
+		{"empty ip", &pb.Port{PublicPort: 80}, &pb.Port{PublicPort: 80, Ip: "10.0.0.1"}, true},
+	}
+	for _, tt := range tests {
+		if got := isPortConflict(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: isPortConflict(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSamePorts(t *testing.T) {
+	p80 := &pb.Port{PublicPort: 80, PrivatePort: 8080}
+	p443 := &pb.Port{PublicPort: 443, PrivatePort: 8443}
+	tests := []struct {
+		name string
+		a, b []*pb.Port
+		want bool
+	}{
+		{"empty", nil, nil, true},
+		{"same order", []*pb.Port{p80, p443}, []*pb.Port{p80, p443}, true},
+		{"different order", []*pb.Port{p80, p443}, []*pb.Port{p443, p80}, true},
+		{"default type", []*pb.Port{p80}, []*pb.Port{{PublicPort: 80, PrivatePort: 8080, Type: "tcp"}}, true},
+		{"different length", []*pb.Port{p80}, []*pb.Port{p80, p443}, false},
+		{"duplicated", []*pb.Port{p80, p80}, []*pb.Port{p80, p443}, false},
+		{"different private port", []*pb.Port{p80}, []*pb.Port{{PublicPort: 80, PrivatePort: 80}}, false},
+	}
+	for _, tt := range tests {
+		if got := samePorts(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: samePorts = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidPorts(t *testing.T) {
+	tests := []struct {
+		name  string
+		ports []*pb.Port
+		want  bool
+	}{
+		{"empty", nil, true},
+		{"valid", []*pb.Port{{PrivatePort: 80, PublicPort: 8080}, {PrivatePort: 53, PublicPort: 53, Type: "udp"}}, true},
+		{"no private port", []*pb.Port{{PublicPort: 8080}}, false},
+		{"port out of range", []*pb.Port{{PrivatePort: 80, PublicPort: 65536}}, false},
+		{"bad type", []*pb.Port{{PrivatePort: 80, Type: "sctp"}}, false},
+		{"bad ip", []*pb.Port{{PrivatePort: 80, Ip: "256.0.0.1"}}, false},
+		{"conflict", []*pb.Port{{PrivatePort: 80, PublicPort: 8080}, {PrivatePort: 81, PublicPort: 8080}}, false},
+	}
+	for _, tt := range tests {
+		if got := isValidPorts(tt.ports); got != tt.want {
+			t.Errorf("%s: isValidPorts = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidResourceLimit(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *pb.ResourceLimit
+		want bool
+	}{
+		{"nil", nil, true},
+		{"empty", &pb.ResourceLimit{}, true},
+		{"cpuset", &pb.ResourceLimit{CpusetCpus: "0-2,4", CpusetMems: "0,1"}, true},
+		{"bad cpuset", &pb.ResourceLimit{CpusetCpus: "0-"}, false},
+		{"bad cpuset mems", &pb.ResourceLimit{CpusetMems: "a"}, false},
+		{"oom score adj", &pb.ResourceLimit{OomScoreAdj: -1000}, true},
+		{"oom score adj too large", &pb.ResourceLimit{OomScoreAdj: 1001}, false},
+		{"negative pids limit", &pb.ResourceLimit{PidsLimit: -1}, false},
+		{"swap", &pb.ResourceLimit{MemoryLimit: 256, MemorySwap: 512}, true},
+		{"swap equals memory", &pb.ResourceLimit{MemoryLimit: 256, MemorySwap: 256}, true},
+		{"swap less than memory", &pb.ResourceLimit{MemoryLimit: 256, MemorySwap: 128}, false},
+		{"swap without memory", &pb.ResourceLimit{MemorySwap: 512}, false},
+		{"unlimited swap", &pb.ResourceLimit{MemoryLimit: 256, MemorySwap: -1}, true},
+		{"negative swap", &pb.ResourceLimit{MemoryLimit: 256, MemorySwap: -2}, false},
+		{"blkio", &pb.ResourceLimit{BlkioThrottles: []*pb.BlkioThrottle{{Device: "/dev/sda"}, {Device: "/dev/sdb"}}}, true},
+		{"blkio not device", &pb.ResourceLimit{BlkioThrottles: []*pb.BlkioThrottle{{Device: "/tmp/sda"}}}, false},
+		{"blkio escape dev", &pb.ResourceLimit{BlkioThrottles: []*pb.BlkioThrottle{{Device: "/dev/../etc/passwd"}}}, false},
+		{"blkio duplicated", &pb.ResourceLimit{BlkioThrottles: []*pb.BlkioThrottle{{Device: "/dev/sda"}, {Device: "/dev/sda"}}}, false},
+	}
+	for _, tt := range tests {
+		if got := isValidResourceLimit(tt.r); got != tt.want {
+			t.Errorf("%s: isValidResourceLimit(%v) = %v, want %v", tt.name, tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestIsProtectedPath(t *testing.T) {
+	fileList := []string{"/etc/passwd", "/usr/bin/", "/opt/app/../data"}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/etc/passwd", true},
+		{"/etc/passwd.bak", false},
+		{"/etc", false},
+		{"/usr/bin", true},
+		{"/usr/bin/ls", true},
+		{"/usr/bin2/ls", false},
+		{"/opt/data/file", true},
+		{"/opt/app/data", false},
+		{"/usr/bin/../../etc/passwd", true},
+		{"/usr/bin/../lib/x", false},
+	}
+	for _, tt := range tests {
+		if got := isProtectedPath(fileList, tt.path); got != tt.want {
+			t.Errorf("isProtectedPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	if !isProtectedPath([]string{"/"}, "/etc/hosts") {
+		t.Errorf("isProtectedPath with root: want true")
+	}
+}
+
+func TestMarkProtectedChanges(t *testing.T) {
+	changes := []*pb.FileChange{{Path: "/etc"}, {Path: "/etc/passwd"}, {Path: "/tmp/x"}}
+	if got := markProtectedChanges(changes, []string{"/etc/passwd"}); got != 1 {
+		t.Errorf("markProtectedChanges = %v, want 1", got)
+	}
+	for _, c := range changes {
+		if c.Protected != (c.Path == "/etc/passwd") {
+			t.Errorf("change %v protected=%v", c.Path, c.Protected)
+		}
+	}
+}
@@ -114,7 +114,7 @@ func getHash(filename, sendHashStr string) error {
 	}
 
 	hashStr := hex.EncodeToString(hash256.Sum(nil))
-	if hashStr != sendHashStr {
+	if !strings.EqualFold(hashStr, sendHashStr) {
 		log.Errorf("file hash not match: [%v] != [%v]", hashStr, sendHashStr)
 		return rpc.ErrInternal
 	}
@@ -193,121 +193,51 @@ func (s *ImageServer) Upload(stream pb.Image_UploadServer) error {
 		return rpc.ErrUnknown
 	}
 
-	if req.Info == nil || req.Sign == nil {
-		return rpc.ErrInvalidArgument
-	} else if !isValidImageName(req.Info.Name) {
-		return status.Errorf(codes.InvalidArgument, "镜像名参数错误")
-	} else if !isValidImageVersion(req.Info.Version) {
-		return status.Errorf(codes.InvalidArgument, "镜像版本参数错误")
-	} else if !isValidImageDesc(req.Info.Description) {
-		return status.Errorf(codes.InvalidArgument, "镜像描述参数错误")
-	}
-
-	fileName := fmt.Sprintf("%s/%s_%s%s", imageDir(), req.Info.Name, req.Info.Version, req.Info.Type)
-	signFileName := fmt.Sprintf("%s/%s_%s.sign", imageDir(), req.Info.Name, req.Info.Version)
-	{
-		signfile, err := os.Create(signFileName)
-		if err != nil {
-			log.Errorf("cannot create file %v: %v", signFileName, err)
-			return rpc.ErrInternal
-		}
-		defer signfile.Close()
-
-		req, err := stream.Recv()
-		if err != nil {
-			log.Errorf("recv sign err: %v", err)
+	var sess *uploadSession
+	userID := getUserIDFromContext(stream.Context())
+	if req.UploadId != "" {
+		if sess, err = acquireUploadSession(req.UploadId, req.Offset, userID); err != nil {
 			return err
 		}
-
-		size := len(req.ChunkData)
-		if req.Sign.Size != int64(size) {
-			log.Errorf("recv sign size err, send[%v] recv:[%v]", req.Sign.Size, size)
-			return rpc.ErrInternal
-		}
-
-		_, err = signfile.Write(req.ChunkData)
-		if err != nil {
-			log.Errorf("cannot write chunk data to file: %v", err)
-			return rpc.ErrInternal
-		}
-	}
-
-	file, err := os.Create(fileName)
-	if err != nil {
-		log.Errorf("cannot create file %v: %v", fileName, err)
-		return rpc.ErrInternal
-	}
-	defer file.Close()
-
-	var imageSize int64
-	for {
-		if err := contextError(stream.Context()); err != nil {
+	} else {
+		// 未指定上传会话时 签名数据在第二个请求中
+		if err := checkUploadInfo(req.Info, req.Sign); err != nil {
 			return err
 		}
 
-		req, err := stream.Recv()
-		if err == io.EOF {
-			log.Infof("no more data")
-			break
-		}
+		signReq, err := stream.Recv()
 		if err != nil {
-			log.Errorf("cannot receive chunk data: %v", err)
-			return rpc.ErrUnknown
+			log.Errorf("recv sign err: %v", err)
+			return err
 		}
 
-		size := len(req.ChunkData)
-
-		imageSize += int64(size)
-		if imageSize > maxImageSize || imageSize > req.Info.Size {
-			log.Errorf("image is too large: [%v] > [%v] || [%v]", imageSize, maxImageSize, req.Info.Size)
+		if req.Sign.Size != int64(len(signReq.ChunkData)) {
+			log.Errorf("recv sign size err, send[%v] recv:[%v]", req.Sign.Size, len(signReq.ChunkData))
 			return rpc.ErrInvalidArgument
 		}
-		// write slowly
-		time.Sleep(time.Millisecond)
-		_, err = file.Write(req.ChunkData)
-		if err != nil {
-			log.Errorf("cannot write chunk data to file: %v", err)
-			return rpc.ErrInternal
-		}
-	}
-	/*
-		if err = getHash(fileName, req.Info.Checksum); err != nil {
-			tmpErr := os.Remove(fileName)
-			log.Warnf("hash err and remove image file %v: %v", fileName, tmpErr)
+
+		if sess, err = newUploadSession(req.Info, signReq.ChunkData, userID); err != nil {
+			return err
+		} else if sess, err = acquireUploadSession(sess.id, 0, userID); err != nil {
 			return err
 		}
-	*/
-
-	verifyStatus := signVerify(signFileName, fileName)
-
-	imaegId := getImageID(fileName)
-	if imaegId == "" {
-		log.Warnf("the image id of image file %v is wrong ", fileName)
-		return rpc.ErrInvalidArgument
+		req = &pb.UploadRequest{}
 	}
+	defer releaseUploadSession(sess)
 
-	log.Printf("imaegId: [%v]", imaegId)
-
-	imageInfo := model.ImageInfo{
-		Name:         req.Info.Name,
-		Version:      req.Info.Version,
-		Description:  req.Info.Description,
-		FileSize:     req.Info.Size,
-		FileType:     req.Info.Type,
-		CheckSum:     req.Info.Checksum,
-		ImageId:      imaegId,
-		FilePath:     fileName,
-		SignPath:     signFileName,
-		VerifyStatus: verifyStatus,
-	}
-
-	imageId, err := model.CreateImages(imageInfo)
-	if err != nil {
-		return rpc.ErrInternal
+	if err := receiveUploadData(stream, sess, req.ChunkData); err != nil {
+		return err
 	}
 
+	// 数据未上传完成时返回已提交的偏移量 客户端可继续上传
 	res := &pb.UploadReply{
-		ImageId: imageId,
+		UploadId: sess.id,
+		Offset:   sess.offset,
+	}
+	if sess.offset == sess.info.Size {
+		if res.ImageId, err = finishUploadSession(sess); err != nil {
+			return err
+		}
 	}
 
 	err = stream.SendAndClose(res)
@@ -316,7 +246,7 @@ func (s *ImageServer) Upload(stream pb.Image_UploadServer) error {
 		return rpc.ErrUnknown
 	}
 
-	log.Debugf("Upload image %v ok", imageId)
+	log.Debugf("Upload image %v offset=%v ok", res.ImageId, res.Offset)
 
 	return nil
 }
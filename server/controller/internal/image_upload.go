@@ -0,0 +1,390 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/common"
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/image"
+)
+
+// 镜像上传会话 分块数据写入上传目录下的临时文件
+type uploadSession struct {
+	id       string
+	info     *pb.UploadInfo
+	creator  int64 // 创建会话的用户ID
+	dataFile string
+	signFile string
+	metaFile string
+	offset   int64     // 已提交的偏移量
+	active   time.Time // 最近更新时间
+	busy     bool      // 同一会话同时只允许一个上传流
+}
+
+// 上传会话元数据 保存在上传目录中, 控制器重启后恢复会话
+type uploadSessionMeta struct {
+	Info    *pb.UploadInfo `json:"info"`
+	Creator int64          `json:"creator"`
+}
+
+var (
+	uploadSessions      = make(map[string]*uploadSession)
+	uploadSessionsGuard = sync.Mutex{}
+)
+
+func uploadDir() string {
+	return filepath.Join(imageDir(), "uploads")
+}
+
+func uploadTimeout() time.Duration {
+	return time.Duration(common.Config.Controller.UploadTimeout) * time.Second
+}
+
+func checkUploadInfo(info *pb.UploadInfo, sign *pb.SignInfo) error {
+	if info == nil || sign == nil {
+		return rpc.ErrInvalidArgument
+	} else if !isValidImageName(info.Name) {
+		return status.Errorf(codes.InvalidArgument, "镜像名参数错误")
+	} else if !isValidImageVersion(info.Version) {
+		return status.Errorf(codes.InvalidArgument, "镜像版本参数错误")
+	} else if !isValidImageDesc(info.Description) {
+		return status.Errorf(codes.InvalidArgument, "镜像描述参数错误")
+	} else if info.Size <= 0 || info.Size > maxImageSize {
+		return status.Errorf(codes.InvalidArgument, "镜像大小参数错误")
+	} else if len(info.Checksum) != sha256.Size*2 {
+		return status.Errorf(codes.InvalidArgument, "镜像校验和参数错误")
+	}
+	return nil
+}
+
+func uploadSessionFiles(s *uploadSession) {
+	s.dataFile = filepath.Join(uploadDir(), s.id+".part")
+	s.signFile = filepath.Join(uploadDir(), s.id+".sign")
+	s.metaFile = filepath.Join(uploadDir(), s.id+".json")
+}
+
+func newUploadSession(info *pb.UploadInfo, sign []byte, creator int64) (*uploadSession, error) {
+	if err := os.MkdirAll(uploadDir(), 0755); err != nil {
+		log.Errorf("create upload dir err=%v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	s := uploadSession{
+		id:      uuid.New().String(),
+		info:    info,
+		creator: creator,
+		active:  time.Now(),
+	}
+	uploadSessionFiles(&s)
+
+	meta, err := json.Marshal(uploadSessionMeta{Info: info, Creator: creator})
+	if err != nil {
+		log.Errorf("marshal upload session err=%v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	if err := ioutil.WriteFile(s.signFile, sign, 0644); err != nil {
+		log.Errorf("cannot create file %v: %v", s.signFile, err)
+		return nil, rpc.ErrInternal
+	}
+	if err := ioutil.WriteFile(s.dataFile, nil, 0644); err != nil {
+		log.Errorf("cannot create file %v: %v", s.dataFile, err)
+		os.Remove(s.signFile)
+		return nil, rpc.ErrInternal
+	}
+	// 元数据最后写入, 存在时会话文件完整
+	if err := ioutil.WriteFile(s.metaFile, meta, 0644); err != nil {
+		log.Errorf("cannot create file %v: %v", s.metaFile, err)
+		os.Remove(s.signFile)
+		os.Remove(s.dataFile)
+		return nil, rpc.ErrInternal
+	}
+
+	uploadSessionsGuard.Lock()
+	uploadSessions[s.id] = &s
+	uploadSessionsGuard.Unlock()
+	return &s, nil
+}
+
+// 控制器重启后从上传目录恢复会话, 已提交偏移量为数据文件大小
+func loadUploadSessions() {
+	files, err := filepath.Glob(filepath.Join(uploadDir(), "*.json"))
+	if err != nil {
+		return
+	}
+
+	for _, f := range files {
+		s := uploadSession{id: strings.TrimSuffix(filepath.Base(f), ".json")}
+		uploadSessionFiles(&s)
+
+		data, err := ioutil.ReadFile(s.metaFile)
+		if err != nil {
+			log.Infof("read upload session file=%v err=%v", s.metaFile, err)
+			continue
+		}
+		var meta uploadSessionMeta
+		if err := json.Unmarshal(data, &meta); err != nil || meta.Info == nil {
+			log.Infof("unmarshal upload session file=%v err=%v", s.metaFile, err)
+			continue
+		}
+
+		fi, err := os.Stat(s.dataFile)
+		if err != nil {
+			log.Infof("stat upload file=%v err=%v", s.dataFile, err)
+			continue
+		} else if _, err := os.Stat(s.signFile); err != nil {
+			log.Infof("stat upload file=%v err=%v", s.signFile, err)
+			continue
+		}
+
+		s.info, s.creator = meta.Info, meta.Creator
+		s.offset, s.active = fi.Size(), fi.ModTime()
+		if s.offset > s.info.Size {
+			s.offset = s.info.Size
+		}
+
+		uploadSessionsGuard.Lock()
+		uploadSessions[s.id] = &s
+		uploadSessionsGuard.Unlock()
+		log.Infof("load upload session=%v offset=%v size=%v", s.id, s.offset, s.info.Size)
+	}
+}
+
+// 上传会话仅允许创建者访问
+func checkUploadSessionOwner(s *uploadSession, userID int64) error {
+	if s.creator != userID {
+		return status.Errorf(codes.PermissionDenied, "无权访问该上传会话")
+	}
+	return nil
+}
+
+// 获取上传会话 偏移量须与已提交的偏移量一致
+func acquireUploadSession(id string, offset, userID int64) (*uploadSession, error) {
+	uploadSessionsGuard.Lock()
+	defer uploadSessionsGuard.Unlock()
+
+	s, ok := uploadSessions[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "上传会话不存在或已过期")
+	} else if err := checkUploadSessionOwner(s, userID); err != nil {
+		return nil, err
+	} else if s.busy {
+		return nil, status.Errorf(codes.FailedPrecondition, "上传会话正在使用")
+	} else if s.offset != offset {
+		return nil, status.Errorf(codes.FailedPrecondition, "上传偏移量不匹配, 已提交%d", s.offset)
+	}
+
+	s.busy = true
+	s.active = time.Now()
+	return s, nil
+}
+
+func releaseUploadSession(s *uploadSession) {
+	uploadSessionsGuard.Lock()
+	s.busy = false
+	s.active = time.Now()
+	uploadSessionsGuard.Unlock()
+}
+
+func removeUploadSession(s *uploadSession) {
+	uploadSessionsGuard.Lock()
+	delete(uploadSessions, s.id)
+	uploadSessionsGuard.Unlock()
+
+	for _, f := range []string{s.metaFile, s.dataFile, s.signFile} {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			log.Infof("remove upload file=%v err=%v", f, err)
+		}
+	}
+}
+
+// 接收分块数据 每次写入成功后提交偏移量
+func receiveUploadData(stream pb.Image_UploadServer, s *uploadSession, data []byte) error {
+	file, err := os.OpenFile(s.dataFile, os.O_WRONLY, 0)
+	if err != nil {
+		log.Errorf("cannot open file %v: %v", s.dataFile, err)
+		return rpc.ErrInternal
+	}
+	defer file.Close()
+
+	// 丢弃上次中断时未提交的数据
+	if err := file.Truncate(s.offset); err != nil {
+		return rpc.ErrInternal
+	} else if _, err := file.Seek(s.offset, io.SeekStart); err != nil {
+		return rpc.ErrInternal
+	}
+
+	for {
+		if s.offset+int64(len(data)) > s.info.Size {
+			log.Errorf("image is too large: [%v] > [%v]", s.offset+int64(len(data)), s.info.Size)
+			return rpc.ErrInvalidArgument
+		}
+
+		if _, err := file.Write(data); err != nil {
+			log.Errorf("cannot write chunk data to file: %v", err)
+			return rpc.ErrInternal
+		}
+
+		uploadSessionsGuard.Lock()
+		s.offset += int64(len(data))
+		s.active = time.Now()
+		uploadSessionsGuard.Unlock()
+
+		if err := contextError(stream.Context()); err != nil {
+			return err
+		}
+
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.Errorf("cannot receive chunk data: %v", err)
+			return rpc.ErrUnknown
+		}
+		data = req.ChunkData
+	}
+}
+
+// 校验完整文件并登记镜像后再移动至镜像目录, 避免覆盖已有镜像文件
+func finishUploadSession(s *uploadSession) (int64, error) {
+	defer removeUploadSession(s)
+
+	if err := getHash(s.dataFile, s.info.Checksum); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "镜像文件校验和不匹配")
+	}
+
+	verifyStatus := signVerify(s.signFile, s.dataFile)
+
+	imaegId := getImageID(s.dataFile)
+	if imaegId == "" {
+		log.Warnf("the image id of image file %v is wrong ", s.dataFile)
+		return 0, rpc.ErrInvalidArgument
+	}
+
+	fileName := fmt.Sprintf("%s/%s_%s%s", imageDir(), s.info.Name, s.info.Version, s.info.Type)
+	signFileName := fmt.Sprintf("%s/%s_%s.sign", imageDir(), s.info.Name, s.info.Version)
+
+	log.Printf("imaegId: [%v]", imaegId)
+
+	imageInfo := model.ImageInfo{
+		Name:         s.info.Name,
+		Version:      s.info.Version,
+		Description:  s.info.Description,
+		FileSize:     s.info.Size,
+		FileType:     s.info.Type,
+		CheckSum:     s.info.Checksum,
+		ImageId:      imaegId,
+		FilePath:     fileName,
+		SignPath:     signFileName,
+		VerifyStatus: verifyStatus,
+	}
+
+	imageId, err := model.CreateImages(imageInfo)
+	if err != nil {
+		return 0, rpc.ErrInternal
+	}
+
+	if err = os.Rename(s.dataFile, fileName); err != nil {
+		log.Errorf("rename %v to %v err=%v", s.dataFile, fileName, err)
+	} else if err = os.Rename(s.signFile, signFileName); err != nil {
+		log.Errorf("rename %v to %v err=%v", s.signFile, signFileName, err)
+		os.Remove(fileName)
+	}
+	if err != nil {
+		if err := model.RemoveImages([]int64{imageId}); err != nil {
+			log.Warnf("remove image id=%v err=%v", imageId, err)
+		}
+		return 0, rpc.ErrInternal
+	}
+	return imageId, nil
+}
+
+func (s *ImageServer) CreateUpload(ctx context.Context, in *pb.CreateUploadRequest) (*pb.CreateUploadReply, error) {
+	if err := checkUploadInfo(in.Info, in.Sign); err != nil {
+		return nil, err
+	} else if in.Sign.Size != int64(len(in.Sign.ChunkData)) {
+		return nil, status.Errorf(codes.InvalidArgument, "签名数据大小不匹配")
+	}
+
+	sess, err := newUploadSession(in.Info, in.Sign.ChunkData, getUserIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUploadReply{UploadId: sess.id}, nil
+}
+
+func (s *ImageServer) UploadStatus(ctx context.Context, in *pb.UploadStatusRequest) (*pb.UploadStatusReply, error) {
+	uploadSessionsGuard.Lock()
+	defer uploadSessionsGuard.Unlock()
+
+	sess, ok := uploadSessions[in.UploadId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "上传会话不存在或已过期")
+	} else if err := checkUploadSessionOwner(sess, getUserIDFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &pb.UploadStatusReply{
+		UploadId: sess.id,
+		Offset:   sess.offset,
+		Size:     sess.info.Size,
+	}, nil
+}
+
+func cleanUploadSessions() {
+	var expired []*uploadSession
+	uploadSessionsGuard.Lock()
+	for _, s := range uploadSessions {
+		if !s.busy && time.Since(s.active) > uploadTimeout() {
+			expired = append(expired, s)
+		}
+	}
+	uploadSessionsGuard.Unlock()
+
+	for _, s := range expired {
+		log.Infof("upload session=%v expired offset=%v size=%v", s.id, s.offset, s.info.Size)
+		removeUploadSession(s)
+	}
+
+	// 控制器重启前遗留的文件
+	files, err := ioutil.ReadDir(uploadDir())
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		id := strings.TrimSuffix(f.Name(), filepath.Ext(f.Name()))
+		uploadSessionsGuard.Lock()
+		_, ok := uploadSessions[id]
+		uploadSessionsGuard.Unlock()
+		if !ok && time.Since(f.ModTime()) > uploadTimeout() {
+			if err := os.Remove(filepath.Join(uploadDir(), f.Name())); err != nil {
+				log.Infof("remove upload file=%v err=%v", f.Name(), err)
+			}
+		}
+	}
+}
+
+func CleanUploadSessions() {
+	loadUploadSessions()
+	for {
+		cleanUploadSessions()
+		time.Sleep(time.Minute)
+	}
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -18,6 +19,13 @@ type NodeServer struct {
 	pb.UnimplementedNodeServer
 }
 
+func nodeAllowedDevices(n *model.NodeInfo) []string {
+	if n.AllowedDevices == "" {
+		return nil
+	}
+	return strings.Split(n.AllowedDevices, ",")
+}
+
 func (s *NodeServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListReply, error) {
 	reply := pb.ListReply{}
 
@@ -39,6 +47,9 @@ func (s *NodeServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListRepl
 				MemoryLimit: node.MemoryLimit,
 				DiskLimit:   node.DiskLimit,
 			},
+			DeviceAllowlist: &pb.DeviceAllowlist{
+				Paths: nodeAllowedDevices(&node),
+			},
 			Status: s,
 		})
 	}
@@ -169,6 +180,8 @@ func (s *NodeServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "节点名长度限制1-50")
 	} else if utf8.RuneCountInString(in.Comment) > 200 {
 		return nil, status.Errorf(codes.InvalidArgument, "节点备注长度限制0-200")
+	} else if in.DeviceAllowlist != nil && !isValidDeviceAllowlist(in.DeviceAllowlist.Paths) {
+		return nil, status.Errorf(codes.InvalidArgument, "设备路径参数错误")
 	}
 
 	nodeInfo, err := model.QueryNodeByID(in.NodeId)
@@ -193,6 +206,9 @@ func (s *NodeServer) Update(ctx context.Context, in *pb.UpdateRequest) (*pb.Upda
 		nodeInfo.MemoryLimit = in.RscLimit.MemoryLimit
 		nodeInfo.DiskLimit = in.RscLimit.DiskLimit
 	}
+	if in.DeviceAllowlist != nil {
+		nodeInfo.AllowedDevices = strings.Join(in.DeviceAllowlist.Paths, ",")
+	}
 
 	if err := model.UpdateNode(nodeInfo); err != nil {
 		log.Infof("UpdateNode %+v err=%v", nodeInfo, err)
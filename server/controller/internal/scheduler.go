@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/common"
+	"scmc/model"
+	pb "scmc/rpc/pb/container"
+	"scmc/rpc/pb/node"
+)
+
+const (
+	scheduleSpread  = "spread"
+	scheduleBinpack = "binpack"
+)
+
+type scheduleCandidate struct {
+	node   *model.NodeInfo
+	status *node.NodeStatus
+	score  float64 // 调度后剩余资源比例
+	reason string  // 不满足调度条件的原因
+}
+
+func (c *scheduleCandidate) nodeInfo() string {
+	return fmt.Sprintf("%s (%s)", c.node.Name, c.node.Address)
+}
+
+// 节点资源容量 优先使用节点配置的资源限制 未配置时使用节点实际容量
+func nodeCapacity(limit float64, total float64) float64 {
+	if limit > 0 {
+		return limit
+	}
+	return total
+}
+
+// 检查单项资源: 已分配总和与实时使用量加上申请量均不能超过节点容量, 返回调度后剩余比例
+func checkResource(name, unit string, capacity, allocated, used, request float64) (float64, string) {
+	if request <= 0 {
+		if capacity <= 0 {
+			return 0, ""
+		}
+		return (capacity - allocated) / capacity, ""
+	}
+
+	if capacity <= 0 {
+		return 0, fmt.Sprintf("%s容量未知", name)
+	}
+	if allocated+request > capacity {
+		return 0, fmt.Sprintf("%s分配不足: 已分配%.2f%s 申请%.2f%s 容量%.2f%s", name, allocated, unit, request, unit, capacity, unit)
+	}
+	if used+request > capacity {
+		return 0, fmt.Sprintf("%s可用不足: 已使用%.2f%s 申请%.2f%s 容量%.2f%s", name, used, unit, request, unit, capacity, unit)
+	}
+
+	return (capacity - allocated - request) / capacity, ""
+}
+
+func (c *scheduleCandidate) evaluate(rsc *pb.ResourceLimit) {
+	s := c.status
+	if s == nil {
+		c.reason = "节点离线"
+		return
+	} else if s.State != int64(node.NodeState_Online) {
+		c.reason = "节点状态异常"
+		return
+	}
+
+	allocated := s.Allocated
+	if allocated == nil {
+		allocated = &node.ResourceLimit{}
+	}
+
+	var scores []float64
+	var cpuTotal, cpuUsed float64
+	if s.CpuStat != nil {
+		cpuTotal, cpuUsed = s.CpuStat.Total, s.CpuStat.Used
+	}
+	score, reason := checkResource("CPU", "核", nodeCapacity(c.node.CpuLimit, cpuTotal), allocated.CpuLimit, cpuUsed, rsc.GetCpuLimit())
+	if reason != "" {
+		c.reason = reason
+		return
+	}
+	scores = append(scores, score)
+
+	var memTotal, memUsed float64
+	if s.MemStat != nil {
+		memTotal, memUsed = float64(s.MemStat.Total), float64(s.MemStat.Used)
+	}
+	score, reason = checkResource("内存", "MB", nodeCapacity(c.node.MemoryLimit, memTotal), allocated.MemoryLimit, memUsed, rsc.GetMemoryLimit())
+	if reason != "" {
+		c.reason = reason
+		return
+	}
+	scores = append(scores, score)
+
+	// agent上报的磁盘数据单位为字节
+	var diskTotal, diskUsed float64
+	if s.DiskStat != nil {
+		diskTotal, diskUsed = float64(s.DiskStat.Total)/(1<<20), float64(s.DiskStat.Used)/(1<<20)
+	}
+	score, reason = checkResource("磁盘", "MB", nodeCapacity(c.node.DiskLimit, diskTotal), allocated.DiskLimit, diskUsed, rsc.GetDiskLimit())
+	if reason != "" {
+		c.reason = reason
+		return
+	}
+	scores = append(scores, score)
+
+	for _, v := range scores {
+		c.score += v
+	}
+	c.score /= float64(len(scores))
+}
+
+// 节点上已有容器占用申请的主机端口时不可调度
+func (c *scheduleCandidate) checkPorts(ports []*pb.Port) {
+	if len(ports) == 0 {
+		return
+	}
+
+	conn, err := getAgentConn(c.node.Address)
+	if err != nil {
+		c.reason = "连接节点失败"
+		return
+	}
+
+	if err := checkPortConflict(conn, ports, ""); err != nil {
+		if s, _ := status.FromError(err); s != nil && s.Code() == codes.AlreadyExists {
+			c.reason = s.Message()
+		} else {
+			c.reason = "查询节点端口占用失败"
+		}
+	}
+}
+
+// scheduleNode 按资源需求, 端口占用及调度策略选择节点, 返回选中节点及其他节点未选中的原因
+func scheduleNode(rsc *pb.ResourceLimit, ports []*pb.Port) (*model.NodeInfo, []*pb.NodeScheduleInfo, error) {
+	nodes, err := model.ListNodes()
+	if err != nil {
+		log.Infof("get node list from DB err=%v", err)
+		return nil, nil, err
+	}
+
+	candidates := make([]*scheduleCandidate, len(nodes))
+	var wg sync.WaitGroup
+	for i := range nodes {
+		candidates[i] = &scheduleCandidate{node: &nodes[i]}
+		wg.Add(1)
+		go func(c *scheduleCandidate) {
+			defer wg.Done()
+			c.status, _ = getNodeStatus(c.node)
+			c.evaluate(rsc)
+			if c.reason == "" {
+				c.checkPorts(ports)
+			}
+		}(candidates[i])
+	}
+	wg.Wait()
+
+	var available []*scheduleCandidate
+	for _, c := range candidates {
+		if c.reason == "" {
+			available = append(available, c)
+		}
+	}
+
+	strategy := common.Config.Controller.ScheduleStrategy
+	if strategy != scheduleBinpack {
+		strategy = scheduleSpread
+	}
+	sort.SliceStable(available, func(i, j int) bool {
+		if strategy == scheduleBinpack {
+			return available[i].score < available[j].score
+		}
+		return available[i].score > available[j].score
+	})
+
+	var chosen *scheduleCandidate
+	if len(available) > 0 {
+		chosen = available[0]
+	}
+
+	var rejects []*pb.NodeScheduleInfo
+	for _, c := range candidates {
+		if c == chosen {
+			continue
+		}
+
+		reason := c.reason
+		if reason == "" {
+			reason = fmt.Sprintf("%s策略下优先级较低: 剩余资源比例%.2f%% 选中节点%.2f%%", strategy, c.score*100, chosen.score*100)
+		}
+		rejects = append(rejects, &pb.NodeScheduleInfo{
+			NodeId:   c.node.ID,
+			NodeInfo: c.nodeInfo(),
+			Reason:   reason,
+		})
+	}
+
+	if chosen == nil {
+		var reasons []string
+		for _, r := range rejects {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", r.NodeInfo, r.Reason))
+		}
+		log.Infof("schedule container rsc=%+v no available node: %v", rsc, reasons)
+		return nil, rejects, status.Errorf(codes.ResourceExhausted, "没有满足资源及端口需求的节点 %s", strings.Join(reasons, "; "))
+	}
+
+	log.Infof("schedule container rsc=%+v strategy=%v node=%v", rsc, strategy, chosen.nodeInfo())
+	return chosen.node, rejects, nil
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/security"
+)
+
+func toPbSeccompProfile(p *model.SeccompProfile) *pb.SeccompProfile {
+	return &pb.SeccompProfile{
+		Id:        p.ID,
+		Name:      p.Name,
+		Content:   p.Content,
+		Desc:      p.Desc,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func syncSeccompProfileToNode(conn *grpc.ClientConn, in *pb.SyncSeccompProfileRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	_, err := pb.NewSecurityClient(conn).SyncSeccompProfile(ctx, in)
+	return err
+}
+
+// 下发seccomp配置至所有节点 失败的节点在创建容器前会重新下发
+func syncSeccompProfile(in *pb.SyncSeccompProfileRequest) {
+	nodes, err := model.ListNodes()
+	if err != nil {
+		log.Infof("get node list from DB err=%v", err)
+		return
+	}
+
+	for _, n := range nodes {
+		conn, err := getAgentConn(n.Address)
+		if err != nil {
+			continue
+		}
+		if err := syncSeccompProfileToNode(conn, in); err != nil {
+			log.Infof("sync seccomp profile node=%v err=%v", n.Address, err)
+		}
+	}
+}
+
+// 创建容器前确保节点上存在容器使用的seccomp配置
+func ensureSeccompProfile(conn *grpc.ClientConn, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	p, err := model.GetSeccompProfileByName(name)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return status.Errorf(codes.InvalidArgument, "seccomp配置不存在")
+		}
+		return rpc.ErrInternal
+	}
+
+	if err := syncSeccompProfileToNode(conn, &pb.SyncSeccompProfileRequest{
+		Profiles: []*pb.SeccompProfile{toPbSeccompProfile(p)},
+	}); err != nil {
+		log.Warnf("sync seccomp profile=%v err=%v", name, err)
+		return err
+	}
+	return nil
+}
+
+func isValidSeccompProfile(content, desc string) bool {
+	return content != "" && json.Valid([]byte(content)) && utf8.RuneCountInString(desc) <= 200
+}
+
+func (s *SecurityServer) ListSeccompProfile(ctx context.Context, in *pb.ListSeccompProfileRequest) (*pb.ListSeccompProfileReply, error) {
+	data, err := model.ListSeccompProfiles()
+	if err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	reply := pb.ListSeccompProfileReply{}
+	for _, p := range data {
+		reply.Profiles = append(reply.Profiles, toPbSeccompProfile(p))
+	}
+	return &reply, nil
+}
+
+func (s *SecurityServer) CreateSeccompProfile(ctx context.Context, in *pb.CreateSeccompProfileRequest) (*pb.CreateSeccompProfileReply, error) {
+	if !regexp.MustCompile(seccompNamePattern).MatchString(in.Name) || utf8.RuneCountInString(in.Name) > 50 {
+		return nil, status.Errorf(codes.InvalidArgument, "seccomp配置名参数错误")
+	} else if !isValidSeccompProfile(in.Content, in.Desc) {
+		return nil, status.Errorf(codes.InvalidArgument, "seccomp配置参数错误")
+	}
+
+	data := model.SeccompProfile{
+		Name:    in.Name,
+		Content: in.Content,
+		Desc:    in.Desc,
+	}
+	if err := model.CreateSeccompProfile(&data); err != nil {
+		if err == model.ErrDuplicateKey {
+			return nil, rpc.ErrAlreadyExists
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	syncSeccompProfile(&pb.SyncSeccompProfileRequest{
+		Profiles: []*pb.SeccompProfile{toPbSeccompProfile(&data)},
+	})
+	return &pb.CreateSeccompProfileReply{Id: data.ID}, nil
+}
+
+func (s *SecurityServer) UpdateSeccompProfile(ctx context.Context, in *pb.UpdateSeccompProfileRequest) (*pb.UpdateSeccompProfileReply, error) {
+	if in.Id <= 0 || !isValidSeccompProfile(in.Content, in.Desc) {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	data, err := model.GetSeccompProfile(in.Id)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, rpc.ErrNotFound
+		}
+		return nil, rpc.ErrInternal
+	}
+
+	data.Content = in.Content
+	data.Desc = in.Desc
+	if err := model.UpdateSeccompProfile(data); err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	// 已创建的容器不受影响 新配置在之后创建的容器中生效
+	syncSeccompProfile(&pb.SyncSeccompProfileRequest{
+		Profiles: []*pb.SeccompProfile{toPbSeccompProfile(data)},
+	})
+	return &pb.UpdateSeccompProfileReply{}, nil
+}
+
+func (s *SecurityServer) RemoveSeccompProfile(ctx context.Context, in *pb.RemoveSeccompProfileRequest) (*pb.RemoveSeccompProfileReply, error) {
+	ids := uniqueInt64(in.Ids)
+	if len(ids) == 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	var names []string
+	for _, id := range ids {
+		data, err := model.GetSeccompProfile(id)
+		if err != nil {
+			if err == model.ErrRecordNotFound {
+				return nil, rpc.ErrNotFound
+			}
+			return nil, rpc.ErrInternal
+		}
+
+		count, err := model.CountSeccompProfileUsage(data.Name)
+		if err != nil {
+			return nil, rpc.ErrInternal
+		} else if count > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "seccomp配置正在被容器使用")
+		}
+		names = append(names, data.Name)
+	}
+
+	if err := model.RemoveSeccompProfiles(ids); err != nil {
+		return nil, rpc.ErrInternal
+	}
+
+	syncSeccompProfile(&pb.SyncSeccompProfileRequest{Removed: names})
+	return &pb.RemoveSeccompProfileReply{}, nil
+}
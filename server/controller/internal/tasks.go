@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"scmc/common"
 	"scmc/model"
@@ -84,6 +88,14 @@ func GetBackupJob(conn *grpc.ClientConn, id int64) (*pb.GetBackupJobReply, error
 	return cli.GetBackupJob(ctx, &pb.GetBackupJobRequest{Id: id})
 }
 
+func ListBackupJob(conn *grpc.ClientConn, ids []int64) (*pb.ListBackupJobReply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	cli := pb.NewContainerClient(conn)
+	return cli.ListBackupJob(ctx, &pb.ListBackupJobRequest{Ids: ids})
+}
+
 func DelBackupJob(conn *grpc.ClientConn, id int64) (*pb.DelBackupJobReply, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
@@ -92,6 +104,12 @@ func DelBackupJob(conn *grpc.ClientConn, id int64) (*pb.DelBackupJobReply, error
 	return cli.DelBackupJob(ctx, &pb.DelBackupJobRequest{Id: id})
 }
 
+// 节点无法连接超过该时长后 进行中的备份任务置为失败
+const backupJobUnreachableGrace = time.Minute * 30
+
+// 备份任务所在节点首次无法连接的时间, 仅在CheckContainerBackupJob中访问
+var backupJobUnreachableSince = make(map[int64]time.Time)
+
 func checkContainerBackupJob() {
 	jobs, err := model.QueryUndoneContainerBackup()
 	if err != nil {
@@ -99,44 +117,176 @@ func checkContainerBackupJob() {
 		return
 	}
 
+	undone := make(map[int64]bool)
 	for _, job := range jobs {
+		undone[job.ID] = true
+
 		nodeInfo, err := model.QueryNodeByID(job.NodeID)
-		if err != nil {
+		if err == model.ErrRecordNotFound {
+			log.Infof("node=%v of backup=%v removed", job.NodeID, job.ID)
+			failBackupJob(job)
+			continue
+		} else if err != nil {
 			log.Warnf("model.QueryNodeByID node_id=%v err=%v", job.NodeID, err)
 			continue
 		}
 
+		var rep *pb.GetBackupJobReply
 		conn, err := getAgentConn(nodeInfo.Address)
+		if err == nil {
+			rep, err = GetBackupJob(conn, job.ID)
+		}
+		if status.Code(err) == codes.NotFound {
+			// agent上的任务已丢失
+			rep, err = &pb.GetBackupJobReply{Id: job.ID, Status: int64(pb.BACKUP_STATUS_FAILED)}, nil
+		}
 		if err != nil {
-			log.Warnf("get agent conn addr=%v err=%v", nodeInfo.Address, err)
+			log.Warnf("GetBackupJob id=%v node=%v err=%v", job.ID, nodeInfo.Address, err)
+			since, ok := backupJobUnreachableSince[job.ID]
+			if !ok {
+				backupJobUnreachableSince[job.ID] = time.Now()
+			} else if time.Since(since) > backupJobUnreachableGrace {
+				failBackupJob(job)
+			}
 			continue
 		}
+		delete(backupJobUnreachableSince, job.ID)
 
-		rep, err := GetBackupJob(conn, job.ID)
-		if err != nil {
-			log.Warnf("GetBackupJob id=%v err=%v", job.ID, err)
-			continue
+		// 任务在agent上排队或执行 不限制耗时
+		if rep.Status != int64(pb.BACKUP_STATUS_ONGOING) {
+			finishBackupJob(conn, job, rep)
 		}
+	}
 
-		if rep.Status != 0 || time.Since(time.Unix(rep.UpdatedAt, 0)) > time.Minute*5 {
-			job.ImageRef = rep.ImageRef
-			job.ImageID = rep.ImageId
-			job.ImageSize = rep.ImageSize
-			job.Status = int8(rep.Status)
-			if job.Status == 0 { // 超时
-				job.Status = 2
-			}
+	for id := range backupJobUnreachableSince {
+		if !undone[id] {
+			delete(backupJobUnreachableSince, id)
+		}
+	}
+}
 
-			if err := model.UpdateContainerBackup(job); err != nil {
-				log.Warnf("model.UpdateContainerBackup id=%v err=%v", job.ID, err)
-				continue
+// 节点已删除或长时间无法连接, 节点恢复后agent推送的任务结果由watchNodeBackupJobs清理
+func failBackupJob(job *model.ContainerBackup) {
+	job.Status = int8(pb.BACKUP_STATUS_FAILED)
+	if err := model.UpdateContainerBackup(job); err != nil {
+		log.Warnf("model.UpdateContainerBackup id=%v err=%v", job.ID, err)
+		return
+	}
+	delete(backupJobUnreachableSince, job.ID)
+}
+
+// 已置为失败的备份任务在agent上完成, 删除生成的镜像及归档
+func cleanStaleBackupJob(conn *grpc.ClientConn, rep *pb.GetBackupJobReply) {
+	if rep.ImageRef != "" || len(rep.Archives) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		request := pb.RemoveBackupRequest{Id: rep.Id, ImageRef: rep.ImageRef}
+		if len(rep.Archives) > 0 {
+			request.BackupName = rep.BackupName
+		}
+		if _, err := pb.NewContainerClient(conn).RemoveBackup(ctx, &request); err != nil {
+			log.Warnf("agent RemoveBackup image=%v err=%v", rep.ImageRef, err)
+			return
+		}
+	}
+
+	if _, err := DelBackupJob(conn, rep.Id); err != nil {
+		log.Warnf("DelBackupJob id=%v err=%v", rep.Id, err)
+	}
+}
+
+// 更新备份记录为任务结果, 并删除agent上的任务
+func finishBackupJob(conn *grpc.ClientConn, job *model.ContainerBackup, rep *pb.GetBackupJobReply) {
+	job.ImageRef = rep.ImageRef
+	job.ImageID = rep.ImageId
+	job.ImageSize = rep.ImageSize
+	setBackupArchives(job, rep.Archives)
+	job.Status = int8(rep.Status)
+	if err := model.UpdateContainerBackup(job); err != nil {
+		log.Warnf("model.UpdateContainerBackup id=%v err=%v", job.ID, err)
+		return
+	}
+
+	if rep.Error != "" {
+		log.Infof("backup id=%v failed on agent err=%v", job.ID, rep.Error)
+	}
+	if _, err := DelBackupJob(conn, job.ID); err != nil {
+		log.Warnf("DelBackupJob id=%v err=%v", job.ID, err)
+	}
+}
+
+// 正在接收备份任务结果的节点
+var backupJobWatchers = struct {
+	sync.Mutex
+	nodes map[int64]bool
+}{nodes: make(map[int64]bool)}
+
+// 接收节点推送的已结束备份任务, 连接断开或不再是主控制器时退出
+func watchNodeBackupJobs(n model.NodeInfo) {
+	defer func() {
+		backupJobWatchers.Lock()
+		delete(backupJobWatchers.nodes, n.ID)
+		backupJobWatchers.Unlock()
+	}()
+
+	conn, err := getAgentConn(n.Address)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewContainerClient(conn).WatchBackupJob(ctx, &pb.WatchBackupJobRequest{})
+	if err != nil {
+		log.Infof("WatchBackupJob node=%v err=%v", n.Address, err)
+		return
+	}
+
+	for {
+		rep, err := stream.Recv()
+		if err != nil {
+			log.Infof("WatchBackupJob node=%v recv err=%v", n.Address, err)
+			return
+		} else if !isMaster() {
+			return
+		}
+
+		job, err := model.QueryContainerBackupByID(rep.Id)
+		if err != nil {
+			if err == model.ErrRecordNotFound {
+				cleanStaleBackupJob(conn, rep)
 			}
+			continue
+		}
+		if job.Status == int8(pb.BACKUP_STATUS_ONGOING) {
+			finishBackupJob(conn, job, rep)
+		} else if job.ImageRef == "" && job.Archives == "" {
+			cleanStaleBackupJob(conn, rep)
+		} else {
+			DelBackupJob(conn, rep.Id)
+		}
+	}
+}
 
-			_, err = DelBackupJob(conn, job.ID)
-			if err != nil {
-				log.Warnf("DelBackupJob id=%v err=%v", job.ID, err)
+// 主控制器与各节点保持连接 备份任务结束后立即更新备份记录
+func WatchBackupJobs() {
+	for {
+		if isMaster() {
+			if nodes, err := model.ListNodes(); err != nil {
+				log.Infof("get node list from DB err=%v", err)
+			} else {
+				for _, n := range nodes {
+					backupJobWatchers.Lock()
+					if !backupJobWatchers.nodes[n.ID] {
+						backupJobWatchers.nodes[n.ID] = true
+						go watchNodeBackupJobs(n)
+					}
+					backupJobWatchers.Unlock()
+				}
 			}
 		}
+		time.Sleep(time.Second * 10)
 	}
 }
 
@@ -149,6 +299,56 @@ func CheckContainerBackupJob() {
 	}
 }
 
+// 备份镜像推送至仓库的最大尝试次数
+const maxBackupPushAttempts = 5
+
+func pushContainerBackups() {
+	backups, err := model.QueryUnpushedContainerBackup(maxBackupPushAttempts)
+	if err != nil {
+		return
+	}
+
+	for _, b := range backups {
+		nodeInfo, err := model.QueryNodeByID(b.NodeID)
+		if err != nil {
+			continue
+		}
+
+		conn, err := getAgentConn(nodeInfo.Address)
+		if err != nil {
+			continue
+		}
+
+		registryRef := model.BackupRegistryRef(b.UUID, b.BackupName)
+		ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+		_, err = pb.NewContainerClient(conn).PushBackup(ctx, &pb.PushBackupRequest{
+			ImageRef:    b.ImageRef,
+			RegistryRef: registryRef,
+		})
+		cancel()
+		if err != nil {
+			log.Infof("push backup id=%v image=%v node=%v err=%v", b.ID, b.ImageRef, nodeInfo.Address, err)
+			b.PushAttempts++
+		} else {
+			b.RegistryRef = registryRef
+		}
+
+		if err := model.UpdateContainerBackupPush(b); err != nil {
+			log.Warnf("model.UpdateContainerBackupPush id=%v err=%v", b.ID, err)
+		}
+	}
+}
+
+// 备份成功后推送至仓库, 节点故障时可在其他节点恢复
+func PushContainerBackups() {
+	for {
+		if isMaster() {
+			pushContainerBackups()
+		}
+		time.Sleep(time.Minute)
+	}
+}
+
 func ListContainer(conn *grpc.ClientConn, listAll bool) (*pb.ListReply, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
@@ -239,3 +439,184 @@ func DetectIllegalContainer() {
 		time.Sleep(time.Minute)
 	}
 }
+
+// 容器连续两次检测均为unhealthy时告警, 恢复前不重复告警
+type UnhealthyContainerDetection struct {
+	unhealthy map[string]int // key: node_id/container_id value: 连续检测到unhealthy的次数
+}
+
+// 返回false表示未获取到节点容器列表
+func (t *UnhealthyContainerDetection) ScanNodeContainers(n *model.NodeInfo, seen map[string]bool) bool {
+	conn, err := getAgentConn(n.Address)
+	if err != nil {
+		log.Warnf("get agent conn addr=%v err=%v", n.Address, err)
+		return false
+	}
+
+	r, err := ListContainer(conn, false)
+	if err != nil {
+		log.Warnf("ListContainer err=%v", err)
+		return false
+	}
+
+	for _, c := range r.Containers {
+		if c.Info == nil || c.Info.Health != "unhealthy" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s", n.ID, c.Info.Id)
+		seen[key] = true
+		t.unhealthy[key]++
+		if t.unhealthy[key] != 2 {
+			continue
+		}
+
+		model.CreateWarnLog([]*model.WarnLog{
+			{
+				NodeId:        n.ID,
+				NodeInfo:      fmt.Sprintf("%s (%s)", n.Name, n.Address),
+				EventType:     int64(logging.EVENT_TYPE_WARN_CONTAINER_UNHEALTHY),
+				EventModule:   int64(logging.EVENT_MODULE_CONTAINER),
+				ContainerName: c.Info.Name,
+				Detail:        "容器健康检查持续失败",
+			},
+		})
+	}
+	return true
+}
+
+func (t *UnhealthyContainerDetection) Run() {
+	nodes, err := model.ListNodes()
+	if err != nil {
+		log.Infof("get node list from DB err=%v", err)
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, n := range nodes {
+		if t.ScanNodeContainers(&n, seen) {
+			continue
+		}
+		// 本次未检测的节点保留计数
+		prefix := fmt.Sprintf("%d/", n.ID)
+		for k := range t.unhealthy {
+			if strings.HasPrefix(k, prefix) {
+				seen[k] = true
+			}
+		}
+	}
+
+	for k := range t.unhealthy {
+		if !seen[k] {
+			delete(t.unhealthy, k)
+		}
+	}
+}
+
+func DetectUnhealthyContainer() {
+	t := UnhealthyContainerDetection{unhealthy: make(map[string]int)}
+	for {
+		if isMaster() {
+			t.Run()
+		}
+		time.Sleep(time.Minute)
+	}
+}
+
+type FileTamperDetection struct {
+	reported map[string]map[string]bool // key: node_id/container_id value: 已告警的保护文件变化
+}
+
+var fileChangeKindName = map[pb.FILE_CHANGE_KIND]string{
+	pb.FILE_CHANGE_KIND_MODIFIED: "修改",
+	pb.FILE_CHANGE_KIND_ADDED:    "新增",
+	pb.FILE_CHANGE_KIND_DELETED:  "删除",
+}
+
+func (t *FileTamperDetection) ScanContainer(n *model.NodeInfo, conn *grpc.ClientConn, cfgs *model.ContainerConfigs, fileList []string, seen map[string]bool) {
+	key := fmt.Sprintf("%d/%s", n.ID, cfgs.ContainerID)
+	seen[key] = true // 查询失败时保留已告警记录, 避免重复告警
+
+	r, err := containerDiff(conn, n.ID, cfgs.ContainerID, fileList)
+	if err != nil {
+		log.Infof("container diff node=%v container=%v err=%v", n.Address, cfgs.ContainerID, err)
+		return
+	}
+
+	if t.reported[key] == nil {
+		t.reported[key] = make(map[string]bool)
+	}
+
+	var warnLogs []*model.WarnLog
+	for _, c := range r.Changes {
+		change := fmt.Sprintf("%d:%s", c.Kind, c.Path)
+		if !c.Protected || t.reported[key][change] {
+			continue
+		}
+
+		t.reported[key][change] = true
+		warnLogs = append(warnLogs, &model.WarnLog{
+			NodeId:        n.ID,
+			NodeInfo:      fmt.Sprintf("%s (%s)", n.Name, n.Address),
+			EventType:     int64(logging.EVENT_TYPE_WARN_CONTAINER_FILE_TAMPERED),
+			EventModule:   int64(logging.EVENT_MODULE_CONTAINER),
+			ContainerID:   cfgs.ContainerID,
+			ContainerName: cfgs.ContainerName,
+			Detail:        fmt.Sprintf("防篡改保护文件被%s 路径=%s", fileChangeKindName[c.Kind], c.Path),
+		})
+	}
+
+	if len(warnLogs) > 0 {
+		model.CreateWarnLog(warnLogs)
+	}
+}
+
+func (t *FileTamperDetection) Run() {
+	nodes, err := model.ListNodes()
+	if err != nil {
+		log.Infof("get node list from DB err=%v", err)
+		return
+	}
+
+	containerConfigs, err := model.ListContainerConfigs()
+	if err != nil {
+		log.Infof("get container configs from DB err=%v", err)
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, n := range nodes {
+		conn, err := getAgentConn(n.Address)
+		if err != nil {
+			continue
+		}
+
+		for i := range containerConfigs {
+			cfgs := &containerConfigs[i]
+			if cfgs.NodeID != n.ID || cfgs.ContainerID == "" {
+				continue
+			}
+
+			if fileList := protectedFiles(cfgs); len(fileList) > 0 {
+				t.ScanContainer(&n, conn, cfgs, fileList, seen)
+			}
+		}
+	}
+
+	for k := range t.reported {
+		if !seen[k] {
+			delete(t.reported, k)
+		}
+	}
+}
+
+// 定期检查容器防篡改保护文件是否被修改
+func DetectFileTamper() {
+	t := FileTamperDetection{reported: make(map[string]map[string]bool)}
+	for {
+		if isMaster() {
+			t.Run()
+		}
+		time.Sleep(time.Minute * 5)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -45,6 +46,17 @@ func getUserFromContext(ctx context.Context) (string, string, bool) {
 	return authorization[:i], authorization[i+1:], true
 }
 
+// 请求用户ID 获取失败时返回0
+func getUserIDFromContext(ctx context.Context) int64 {
+	userID, _, ok := getUserFromContext(ctx)
+	if !ok {
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(userID, 10, 64)
+	return id
+}
+
 type UserServer struct {
 	pb.UnimplementedUserServer
 }
@@ -296,6 +308,8 @@ func (s *UserServer) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (
 				CreatedAt:  roleInfo.CreatedAt,
 				UpdatedAt:  roleInfo.UpdatedAt,
 				Perms:      perms,
+
+				OwnedContainersOnly: roleInfo.OwnedContainersOnly,
 			},
 		},
 	}, nil
@@ -375,6 +389,8 @@ func (s *UserServer) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (
 				CreatedAt:  roleInfo.CreatedAt,
 				UpdatedAt:  roleInfo.UpdatedAt,
 				Perms:      perms,
+
+				OwnedContainersOnly: roleInfo.OwnedContainersOnly,
 			},
 		},
 	}, nil
@@ -438,6 +454,8 @@ func (s *UserServer) ListRole(ctx context.Context, in *pb.ListRoleRequest) (*pb.
 			Perms:      perms,
 			CreatedAt:  r.CreatedAt,
 			UpdatedAt:  r.UpdatedAt,
+
+			OwnedContainersOnly: r.OwnedContainersOnly,
 		})
 	}
 
@@ -463,6 +481,8 @@ func (s *UserServer) CreateRole(ctx context.Context, in *pb.CreateRoleRequest) (
 		Name:       in.RoleInfo.Name,
 		IsEditable: in.RoleInfo.IsEditable,
 		PermsJSON:  string(permsJSON),
+
+		OwnedContainersOnly: in.RoleInfo.OwnedContainersOnly,
 	}
 
 	if err = model.CreateRole(ctx, role); err != nil {
@@ -486,6 +506,8 @@ func (s *UserServer) CreateRole(ctx context.Context, in *pb.CreateRoleRequest) (
 			CreatedAt:  role.CreatedAt,
 			UpdatedAt:  role.UpdatedAt,
 			Perms:      perms,
+
+			OwnedContainersOnly: role.OwnedContainersOnly,
 		},
 	}, nil
 }
@@ -514,6 +536,7 @@ func (s *UserServer) UpdateRole(ctx context.Context, in *pb.UpdateRoleRequest) (
 
 	role.Name = in.RoleInfo.Name
 	role.PermsJSON = string(permsJSON)
+	role.OwnedContainersOnly = in.RoleInfo.OwnedContainersOnly
 
 	if err = model.UpdateRole(ctx, role); err != nil {
 		log.Warnf("role UpdateRole err: %v", err)
@@ -533,6 +556,8 @@ func (s *UserServer) UpdateRole(ctx context.Context, in *pb.UpdateRoleRequest) (
 			CreatedAt:  role.CreatedAt,
 			UpdatedAt:  role.UpdatedAt,
 			Perms:      perms,
+
+			OwnedContainersOnly: role.OwnedContainersOnly,
 		},
 	}, nil
 }
@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"scmc/model"
+	"scmc/rpc"
+	pb "scmc/rpc/pb/volume"
+)
+
+type VolumeServer struct {
+	pb.UnimplementedVolumeServer
+}
+
+func volumeClient(nodeID int64) (pb.VolumeClient, *model.NodeInfo, error) {
+	if nodeID <= 0 {
+		return nil, nil, rpc.ErrInvalidArgument
+	}
+
+	nodeInfo, err := model.QueryNodeByID(nodeID)
+	if err != nil {
+		if err == model.ErrRecordNotFound {
+			return nil, nil, rpc.ErrNotFound
+		}
+		return nil, nil, rpc.ErrInternal
+	}
+
+	conn, err := getAgentConn(nodeInfo.Address)
+	if err != nil {
+		return nil, nil, rpc.ErrInternal
+	}
+
+	return pb.NewVolumeClient(conn), nodeInfo, nil
+}
+
+func (s *VolumeServer) List(ctx context.Context, in *pb.ListRequest) (*pb.ListReply, error) {
+	reply := pb.ListReply{}
+
+	nodes, err := model.ListNodes()
+	if err != nil {
+		log.Warnf("query nodes: %v", err)
+		return nil, rpc.ErrInternal
+	}
+
+	var nodeToQuery []*model.NodeInfo
+	if in.NodeIds == nil {
+		for i := range nodes {
+			nodeToQuery = append(nodeToQuery, &nodes[i])
+		}
+	} else {
+		for _, nodeID := range uniqueInt64(in.NodeIds) {
+			for i, node := range nodes {
+				if node.ID == nodeID {
+					nodeToQuery = append(nodeToQuery, &nodes[i])
+					goto next
+				}
+			}
+			log.Infof("node ID=%v not found", nodeID)
+			return nil, rpc.ErrNotFound
+		next:
+		}
+	}
+
+	var (
+		mtx sync.Mutex
+		wg  sync.WaitGroup
+	)
+	for _, node := range nodeToQuery {
+		wg.Add(1)
+		go func(ctx context.Context, node *model.NodeInfo) {
+			defer wg.Done()
+			conn, err := getAgentConn(node.Address)
+			if err != nil {
+				log.Warnf("get agent Conn address=%v: %v", node.Address, err)
+
+				mtx.Lock()
+				defer mtx.Unlock()
+				reply.FailNodes = append(reply.FailNodes, node.Address)
+				return
+			}
+
+			cli := pb.NewVolumeClient(conn)
+			ctx_, cancel := context.WithTimeout(ctx, time.Second*10)
+			defer cancel()
+			subReply, err := cli.List(ctx_, in)
+			if err != nil {
+				log.Warnf("get volume list ID=%v address=%v: %v", node.ID, node.Address, err)
+
+				mtx.Lock()
+				defer mtx.Unlock()
+				reply.FailNodes = append(reply.FailNodes, node.Address)
+				return
+			}
+
+			for i := range subReply.Volumes {
+				subReply.Volumes[i].NodeId = node.ID
+				subReply.Volumes[i].NodeAddress = node.Address
+			}
+			mtx.Lock()
+			defer mtx.Unlock()
+			reply.Volumes = append(reply.Volumes, subReply.Volumes...)
+		}(ctx, node)
+	}
+
+	wg.Wait()
+
+	return &reply, nil
+}
+
+func (s *VolumeServer) Create(ctx context.Context, in *pb.CreateRequest) (*pb.CreateReply, error) {
+	cli, nodeInfo, err := volumeClient(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	reply, err := cli.Create(ctx_, in)
+	if err != nil {
+		log.Warnf("volume create ID=%v address=%v: %v", nodeInfo.ID, nodeInfo.Address, err)
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (s *VolumeServer) Inspect(ctx context.Context, in *pb.InspectRequest) (*pb.InspectReply, error) {
+	if in.Name == "" {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, nodeInfo, err := volumeClient(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	reply, err := cli.Inspect(ctx_, in)
+	if err != nil {
+		log.Warnf("volume inspect ID=%v address=%v: %v", nodeInfo.ID, nodeInfo.Address, err)
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (s *VolumeServer) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb.RemoveReply, error) {
+	if len(in.Names) == 0 {
+		return nil, rpc.ErrInvalidArgument
+	}
+
+	cli, nodeInfo, err := volumeClient(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	reply, err := cli.Remove(ctx_, in)
+	if err != nil {
+		log.Warnf("volume remove ID=%v address=%v: %v", nodeInfo.ID, nodeInfo.Address, err)
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (s *VolumeServer) Prune(ctx context.Context, in *pb.PruneRequest) (*pb.PruneReply, error) {
+	cli, nodeInfo, err := volumeClient(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx_, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	reply, err := cli.Prune(ctx_, in)
+	if err != nil {
+		log.Warnf("volume prune ID=%v address=%v: %v", nodeInfo.ID, nodeInfo.Address, err)
+		return nil, err
+	}
+
+	return reply, nil
+}
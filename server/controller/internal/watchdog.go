@@ -145,6 +145,7 @@ func NodeStatusMonitor() {
 			for _, n := range nodeData {
 				writeNodeWatchLogs(n)
 			}
+			checkNodeFailover(nodeData)
 		}
 		time.Sleep(time.Minute)
 	}
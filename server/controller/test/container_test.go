@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"testing"
 
@@ -463,3 +464,413 @@ func TestContainerResumeBackup(t *testing.T) {
 		}
 	})
 }
+
+func TestContainerResumeBackupToNode(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+
+		request := pb.ResumeBackupRequest{
+			NodeId:       1,
+			BackupId:     3,
+			TargetNodeId: 2,
+		}
+		reply, err := cli.ResumeBackup(ctx, &request)
+
+		if err != nil {
+			t.Errorf("ResumeBackup: %v", err)
+		} else if reply.NodeId != request.TargetNodeId {
+			t.Errorf("ResumeBackup node=%v, expect %v", reply.NodeId, request.TargetNodeId)
+		} else {
+			t.Logf("ResumeBackup reply: %+v", reply)
+		}
+	})
+}
+
+func TestContainerExec(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		stream, err := cli.Exec(ctx)
+		if err != nil {
+			t.Fatalf("Exec: %v", err)
+		}
+
+		if err := stream.Send(&pb.ExecRequest{
+			NodeId:      1,
+			ContainerId: "cadvisor",
+			Cmd:         []string{"/bin/sh", "-c", "ls /; exit 3"},
+		}); err != nil {
+			t.Fatalf("Exec send: %v", err)
+		}
+		stream.CloseSend()
+
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Exec recv: %v", err)
+			}
+
+			if reply.Exited {
+				t.Logf("exit code: %v", reply.ExitCode)
+			} else {
+				t.Logf("stdout: %s stderr: %s", reply.Stdout, reply.Stderr)
+			}
+		}
+	})
+}
+
+func TestContainerLogs(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		stream, err := cli.Logs(ctx, &pb.LogsRequest{
+			NodeId:      1,
+			ContainerId: "cadvisor",
+			Tail:        10,
+			Timestamps:  true,
+		})
+		if err != nil {
+			t.Fatalf("Logs: %v", err)
+		}
+
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Logs recv: %v", err)
+			}
+
+			t.Logf("stdout: %s stderr: %s", reply.Stdout, reply.Stderr)
+		}
+	})
+}
+
+func TestContainerMigrate(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.MigrateRequest{
+			NodeId:       1,
+			ContainerId:  "cadvisor",
+			TargetNodeId: 2,
+		}
+
+		reply, err := cli.Migrate(ctx, &request)
+		if err != nil {
+			t.Errorf("Migrate: %v", err)
+		}
+
+		t.Logf("Migrate reply: %v", reply)
+	})
+}
+
+func TestContainerCreateAutoSchedule(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 0,
+			Configs: &pb.ContainerConfigs{
+				Name:  "schedule-test",
+				Image: "busybox:latest",
+				ResouceLimit: &pb.ResourceLimit{
+					CpuLimit:    1,
+					MemoryLimit: 256,
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+
+		t.Logf("container=%v scheduled to node=%v", reply.ContainerId, reply.NodeId)
+		for _, r := range reply.Rejects {
+			t.Logf("rejected node=%v reason=%v", r.NodeInfo, r.Reason)
+		}
+	})
+}
+
+func TestContainerCreateHA(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "ha-test",
+				Image: "busybox:latest",
+				RestartPolicy: &pb.RestartPolicy{
+					Name: "always",
+					Ha:   true,
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+
+		inspect, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: reply.NodeId, ContainerId: reply.ContainerId})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+
+		if !inspect.Configs.GetRestartPolicy().GetHa() {
+			t.Errorf("container=%v ha not enabled", reply.ContainerId)
+		}
+	})
+}
+
+func TestContainerCreateHealthCheck(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "health-test",
+				Image: "busybox:latest",
+				HealthCheck: &pb.HealthCheck{
+					Cmd:      "exit 1",
+					Interval: 5,
+					Timeout:  3,
+					Retries:  2,
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+
+		inspect, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: reply.NodeId, ContainerId: reply.ContainerId})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+
+		if inspect.Configs.GetHealthCheck().GetCmd() != request.Configs.HealthCheck.Cmd {
+			t.Errorf("health check=%+v not match", inspect.Configs.GetHealthCheck())
+		}
+	})
+}
+
+func TestContainerCreatePorts(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "ports-test",
+				Image: "nginx:latest",
+				Ports: []*pb.Port{
+					{PrivatePort: 80, PublicPort: 8080, Type: "tcp"},
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+
+		inspect, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: reply.NodeId, ContainerId: reply.ContainerId})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+		t.Logf("container=%v ports=%v", reply.ContainerId, inspect.Configs.Ports)
+
+		// 相同主机端口应创建失败
+		request.Configs.Name = "ports-test-conflict"
+		if _, err := cli.Create(ctx, &request); err == nil {
+			t.Errorf("Create with conflict host port should fail")
+		}
+	})
+}
+
+func TestContainerCreateDevices(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "devices-test",
+				Image: "busybox:latest",
+				Devices: []*pb.DeviceMapping{
+					{PathOnHost: "/dev/ttyUSB0", CgroupPermissions: "rw"},
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+		t.Logf("Create reply: %+v", reply)
+
+		// 禁止直通的设备应创建失败
+		request.Configs.Name = "devices-test-denied"
+		request.Configs.Devices = []*pb.DeviceMapping{{PathOnHost: "/dev/mem"}}
+		if _, err := cli.Create(ctx, &request); err == nil {
+			t.Errorf("Create with /dev/mem should fail")
+		}
+	})
+}
+
+func TestContainerCreateHardening(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "hardening-test",
+				Image: "busybox:latest",
+				SecurityConfig: &pb.SecurityConfig{
+					NoNewPrivileges: true,
+					ReadOnlyRootfs:  true,
+					CapDrop:         []string{"ALL"},
+					CapAdd:          []string{"NET_BIND_SERVICE"},
+					Tmpfs:           map[string]string{"/tmp": "size=64m"},
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+		t.Logf("Create reply: %+v", reply)
+
+		inspectReply, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: 1, ContainerId: reply.ContainerId})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+		t.Logf("Inspect security config: %+v", inspectReply.Configs.SecurityConfig)
+
+		// 不存在的seccomp配置应创建失败
+		request.Configs.Name = "hardening-test-seccomp"
+		request.Configs.SecurityConfig.SeccompProfile = "not-exist"
+		if _, err := cli.Create(ctx, &request); err == nil {
+			t.Errorf("Create with unknown seccomp profile should fail")
+		}
+	})
+}
+
+func TestContainerCreateResourceLimit(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "rsc-limit-test",
+				Image: "busybox:latest",
+				ResouceLimit: &pb.ResourceLimit{
+					CpusetCpus:  "0",
+					MemoryLimit: 256,
+					MemorySwap:  512,
+					PidsLimit:   100,
+					OomScoreAdj: 500,
+					BlkioThrottles: []*pb.BlkioThrottle{
+						{Device: "/dev/sda", ReadBps: 10 << 20, WriteIops: 100},
+					},
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+		t.Logf("Create reply: %+v", reply)
+
+		updateRequest := pb.UpdateRequest{
+			NodeId:        1,
+			ContainerId:   reply.ContainerId,
+			ResourceLimit: request.Configs.ResouceLimit,
+		}
+		updateRequest.ResourceLimit.PidsLimit = 200
+		if _, err := cli.Update(ctx, &updateRequest); err != nil {
+			t.Errorf("Update: %v", err)
+		}
+
+		// 块设备IO限制仅创建时生效
+		updateRequest.ResourceLimit.BlkioThrottles = nil
+		if _, err := cli.Update(ctx, &updateRequest); err == nil {
+			t.Errorf("Update blkio throttles should fail")
+		}
+	})
+}
+
+func TestContainerCreateBandwidth(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.CreateRequest{
+			NodeId: 1,
+			Configs: &pb.ContainerConfigs{
+				Name:  "bandwidth-test",
+				Image: "busybox:latest",
+				Networks: []*pb.NetworkConfig{
+					{Interface: "macvlan", IngressRate: 10240, EgressRate: 5120},
+				},
+			},
+		}
+
+		reply, err := cli.Create(ctx, &request)
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+		t.Logf("Create reply: %+v", reply)
+
+		inspectReply, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: 1, ContainerId: reply.ContainerId})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+		t.Logf("Inspect networks: %+v", inspectReply.Configs.Networks)
+	})
+}
+
+func TestContainerExport(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewContainerClient(conn)
+		request := pb.ExportRequest{
+			NodeId:      1,
+			ContainerId: "372c933f30c217990b854a0d1db9addfab9e524764fe6207dfc433d41173cf01",
+		}
+
+		stream, err := cli.Export(ctx, &request)
+		if err != nil {
+			t.Errorf("Export: %v", err)
+			return
+		}
+
+		var size int
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Errorf("Export recv: %v", err)
+				return
+			}
+
+			if r.Info != nil {
+				t.Logf("configs: %+v image config: %+v", r.Info.Configs, r.Info.ImageConfig)
+			}
+			size += len(r.ChunkData)
+		}
+		t.Logf("exported %v bytes", size)
+	})
+}
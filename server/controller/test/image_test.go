@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -133,6 +134,62 @@ func TestImageUpload(t *testing.T) {
 	})
 }
 
+func TestImageResumableUpload(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewImageClient(conn)
+		imagePath := "/root/tmp/hello-world-0221.tar"
+		data, err := ioutil.ReadFile(imagePath)
+		require.NoError(t, err)
+		hashStr, err := GetHash(imagePath)
+		require.NoError(t, err)
+
+		created, err := cli.CreateUpload(ctx, &pb.CreateUploadRequest{
+			Info: &pb.UploadInfo{
+				Name:     "hello-world-0221",
+				Version:  "v1.1",
+				Type:     filepath.Ext(imagePath),
+				Checksum: hashStr,
+				Size:     int64(len(data)),
+			},
+			Sign: &pb.SignInfo{},
+		})
+		require.NoError(t, err)
+
+		// 分两次上传 模拟中断后继续
+		upload := func(offset int64, chunk []byte) *pb.UploadReply {
+			stream, err := cli.Upload(ctx)
+			require.NoError(t, err)
+			err = stream.Send(&pb.UploadRequest{UploadId: created.UploadId, Offset: offset})
+			require.NoError(t, err)
+			for len(chunk) > 0 {
+				n := 1024 * 1024
+				if n > len(chunk) {
+					n = len(chunk)
+				}
+				err = stream.Send(&pb.UploadRequest{ChunkData: chunk[:n]})
+				require.NoError(t, err)
+				chunk = chunk[n:]
+			}
+			reply, err := stream.CloseAndRecv()
+			require.NoError(t, err)
+			return reply
+		}
+
+		half := int64(len(data) / 2)
+		reply := upload(0, data[:half])
+		require.Equal(t, half, reply.Offset)
+		require.Zero(t, reply.ImageId)
+
+		status, err := cli.UploadStatus(ctx, &pb.UploadStatusRequest{UploadId: created.UploadId})
+		require.NoError(t, err)
+		require.Equal(t, half, status.Offset)
+
+		reply = upload(status.Offset, data[half:])
+		require.NotZero(t, reply.ImageId)
+		t.Logf("Upload reply: %+v", reply)
+	})
+}
+
 func TestImageUpdate(t *testing.T) {
 	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
 		cli := pb.NewImageClient(conn)
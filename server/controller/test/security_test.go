@@ -93,3 +93,29 @@ func TestUpdateProcProtection(t *testing.T) {
 		t.Logf("Remove reply: %v", reply)
 	})
 }
+
+func TestSeccompProfile(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewSecurityClient(conn)
+		createReply, err := cli.CreateSeccompProfile(ctx, &pb.CreateSeccompProfileRequest{
+			Name:    "deny-ptrace",
+			Content: `{"defaultAction":"SCMP_ACT_ALLOW","syscalls":[{"names":["ptrace"],"action":"SCMP_ACT_ERRNO"}]}`,
+			Desc:    "禁止ptrace",
+		})
+		if err != nil {
+			t.Errorf("CreateSeccompProfile: %v", err)
+			return
+		}
+		t.Logf("CreateSeccompProfile reply: %+v", createReply)
+
+		listReply, err := cli.ListSeccompProfile(ctx, &pb.ListSeccompProfileRequest{})
+		if err != nil {
+			t.Errorf("ListSeccompProfile: %v", err)
+		}
+		t.Logf("ListSeccompProfile reply: %+v", listReply)
+
+		if _, err := cli.RemoveSeccompProfile(ctx, &pb.RemoveSeccompProfileRequest{Ids: []int64{createReply.Id}}); err != nil {
+			t.Errorf("RemoveSeccompProfile: %v", err)
+		}
+	})
+}
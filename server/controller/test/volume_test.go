@@ -0,0 +1,55 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "scmc/rpc/pb/volume"
+)
+
+func TestVolumeList(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewVolumeClient(conn)
+		reply, err := cli.List(ctx, &pb.ListRequest{})
+		if err != nil {
+			t.Errorf("List: %v", err)
+			return
+		}
+
+		for _, v := range reply.Volumes {
+			t.Logf("node=%v volume=%+v", v.NodeAddress, v.Info)
+		}
+	})
+}
+
+func TestVolumeCreateRemove(t *testing.T) {
+	testRunner(func(ctx context.Context, conn *grpc.ClientConn) {
+		cli := pb.NewVolumeClient(conn)
+		createReply, err := cli.Create(ctx, &pb.CreateRequest{
+			NodeId: 1,
+			Name:   "volume-test",
+			Labels: map[string]string{"desc": "test"},
+		})
+		if err != nil {
+			t.Errorf("Create: %v", err)
+			return
+		}
+		t.Logf("Create reply: %+v", createReply)
+
+		inspectReply, err := cli.Inspect(ctx, &pb.InspectRequest{NodeId: 1, Name: "volume-test"})
+		if err != nil {
+			t.Errorf("Inspect: %v", err)
+			return
+		}
+		t.Logf("Inspect reply: %+v", inspectReply)
+
+		removeReply, err := cli.Remove(ctx, &pb.RemoveRequest{NodeId: 1, Names: []string{"volume-test"}})
+		if err != nil {
+			t.Errorf("Remove: %v", err)
+			return
+		}
+		t.Logf("Remove reply: %+v", removeReply)
+	})
+}
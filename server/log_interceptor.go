@@ -105,8 +105,8 @@ func newWrappedStream(s grpc.ServerStream) grpc.ServerStream {
 	return &wrappedStream{s}
 }
 
-func writeRuntimeLog(ctx context.Context, req interface{}, err error) {
-	// 获取执行用户
+// 获取执行用户
+func requestUserID(ctx context.Context) int64 {
 	var userID int64
 	md, ok := metadata.FromIncomingContext(ctx)
 	if ok {
@@ -125,7 +125,10 @@ func writeRuntimeLog(ctx context.Context, req interface{}, err error) {
 			}
 		}
 	}
+	return userID
+}
 
+func writeRuntimeLog(ctx context.Context, req interface{}, err error) {
 	var logData *model.RuntimeLog
 	switch reqMsg := req.(type) {
 	case *user.LoginRequest:
@@ -158,10 +161,14 @@ func writeRuntimeLog(ctx context.Context, req interface{}, err error) {
 		logData = RuntimeLogWritter{}.RestartContainer(reqMsg)
 	case *container.CreateRequest:
 		logData = RuntimeLogWritter{}.CreateContainer(reqMsg)
+	case *container.CreateFromTemplateRequest:
+		logData = RuntimeLogWritter{}.CreateContainerFromTemplate(reqMsg)
 	case *container.StartRequest:
 		logData = RuntimeLogWritter{}.StartContainer(reqMsg)
 	case *container.StopRequest:
 		logData = RuntimeLogWritter{}.StopContainer(reqMsg)
+	case *container.MigrateRequest:
+		logData = RuntimeLogWritter{}.MigrateContainer(reqMsg)
 	case *image.RemoveRequest:
 		logData = RuntimeLogWritter{}.RemoveImage(reqMsg)
 	case *image.ApproveRequest:
@@ -183,18 +190,23 @@ func writeRuntimeLog(ctx context.Context, req interface{}, err error) {
 	}
 
 	if logData != nil {
-		logData.UserID = userID
-		if s, _ := status.FromError(err); s != nil {
-			logData.StatusCode = int64(s.Code())
-			logData.Error = s.Message()
-		}
+		SaveRuntimeLog(ctx, logData, err)
+	}
+}
 
-		if t, ok := logging.EVENT_TYPE_name[int32(logData.EventType)]; ok {
-			logData.EventType_ = t
-		}
-		data := []*model.RuntimeLog{logData}
-		if err = model.CreateRuntimeLog(data); err != nil {
-			log.Warnf("CreateLog %+v err=%v", logData, err)
-		}
+// SaveRuntimeLog 补充操作用户及错误信息后写入操作日志, 供stream类接口使用
+func SaveRuntimeLog(ctx context.Context, logData *model.RuntimeLog, err error) {
+	logData.UserID = requestUserID(ctx)
+	if s, _ := status.FromError(err); s != nil {
+		logData.StatusCode = int64(s.Code())
+		logData.Error = s.Message()
+	}
+
+	if t, ok := logging.EVENT_TYPE_name[int32(logData.EventType)]; ok {
+		logData.EventType_ = t
+	}
+	data := []*model.RuntimeLog{logData}
+	if err = model.CreateRuntimeLog(data); err != nil {
+		log.Warnf("CreateLog %+v err=%v", logData, err)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"scmc/rpc/pb/logging"
 	"scmc/rpc/pb/node"
 	"scmc/rpc/pb/user"
+	"strings"
 )
 
 type RuntimeLogWritter struct{}
@@ -147,6 +148,16 @@ func (RuntimeLogWritter) CreateContainer(r *container.CreateRequest) *model.Runt
 	return l
 }
 
+func (RuntimeLogWritter) CreateContainerFromTemplate(r *container.CreateFromTemplateRequest) *model.RuntimeLog {
+	return &model.RuntimeLog{
+		EventType:   int64(logging.EVENT_TYPE_CREATE_CONTAINER),
+		EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+		NodeId:      r.NodeId,
+		Target:      fmt.Sprintf("容器名=%v", r.Name),
+		Detail:      fmt.Sprintf("模板ID=%v", r.TemplateId),
+	}
+}
+
 func (RuntimeLogWritter) RemoveContainer(r *container.RemoveRequest) *model.RuntimeLog {
 	return &model.RuntimeLog{
 		EventType:   int64(logging.EVENT_TYPE_REMOVE_CONTAINER),
@@ -183,6 +194,26 @@ func (RuntimeLogWritter) RestartContainer(r *container.RestartRequest) *model.Ru
 	}
 }
 
+func (RuntimeLogWritter) MigrateContainer(r *container.MigrateRequest) *model.RuntimeLog {
+	return &model.RuntimeLog{
+		EventType:   int64(logging.EVENT_TYPE_MIGRATE_CONTAINER),
+		EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+		NodeId:      r.NodeId,
+		Target:      fmt.Sprintf("容器ID=%v", r.ContainerId),
+		Detail:      fmt.Sprintf("源节点ID=%v 目标节点ID=%v", r.NodeId, r.TargetNodeId),
+	}
+}
+
+func (RuntimeLogWritter) ExecContainer(r *container.ExecRequest, exitCode int32) *model.RuntimeLog {
+	return &model.RuntimeLog{
+		EventType:   int64(logging.EVENT_TYPE_EXEC_CONTAINER),
+		EventModule: int64(logging.EVENT_MODULE_CONTAINER),
+		NodeId:      r.NodeId,
+		Target:      fmt.Sprintf("容器ID=%v", r.ContainerId),
+		Detail:      fmt.Sprintf("命令=%v 退出码=%v", strings.Join(r.Cmd, " "), exitCode),
+	}
+}
+
 func (RuntimeLogWritter) UploadImage(r *image.UploadRequest) *model.RuntimeLog {
 	l := &model.RuntimeLog{
 		EventType:   int64(logging.EVENT_TYPE_UPLOAD_IMAGE),